@@ -9,6 +9,9 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// Working hours are evaluated in users' own time zones; embed the zone
+	// database so the distroless image does not depend on the host's.
+	_ "time/tzdata"
 
 	openapi "github.com/TheProgrammer256/PR-Reviewer-Assignment-Service/go"
 
@@ -17,6 +20,7 @@ import (
 	"github.com/avito/pr-reviewer-assignment-service/internal/server"
 	"github.com/avito/pr-reviewer-assignment-service/internal/service"
 	"github.com/avito/pr-reviewer-assignment-service/internal/storage"
+	"github.com/avito/pr-reviewer-assignment-service/internal/worker"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -38,7 +42,11 @@ func main() {
 	}
 
 	repo := storage.NewRepository(pool)
-	apiService := service.New(repo)
+	apiService := service.New(repo, cfg.TeamAliasGracePeriod)
+
+	if cfg.ReactivationInterval > 0 {
+		go worker.RunReactivation(ctx, repo, cfg.ReactivationInterval)
+	}
 
 	pullRequestsController := openapi.NewPullRequestsAPIController(
 		apiService,
@@ -13,6 +13,7 @@ package openapi
 import (
 	"context"
 	"net/http"
+	"time"
 )
 
 
@@ -24,6 +25,13 @@ type PullRequestsAPIRouter interface {
 	CreatePullRequestAndAssign(http.ResponseWriter, *http.Request)
 	UpdateMergedFlag(http.ResponseWriter, *http.Request)
 	ReassignUserOnPullRequest(http.ResponseWriter, *http.Request)
+	GetPullRequestHistory(http.ResponseWriter, *http.Request)
+	SubmitReview(http.ResponseWriter, *http.Request)
+	ClosePullRequest(http.ResponseWriter, *http.Request)
+	ReopenPullRequest(http.ResponseWriter, *http.Request)
+	MarkPullRequestReady(http.ResponseWriter, *http.Request)
+	GetPullRequest(http.ResponseWriter, *http.Request)
+	ListPullRequests(http.ResponseWriter, *http.Request)
 }
 // TeamsAPIRouter defines the required methods for binding the api requests to a responses for the TeamsAPI
 // The TeamsAPIRouter implementation should parse necessary information from the http request,
@@ -31,6 +39,16 @@ type PullRequestsAPIRouter interface {
 type TeamsAPIRouter interface { 
 	CreateTeam(http.ResponseWriter, *http.Request)
 	GetTeam(http.ResponseWriter, *http.Request)
+	UpdateTeamSettings(http.ResponseWriter, *http.Request)
+	AddTeamMembers(http.ResponseWriter, *http.Request)
+	RemoveTeamMember(http.ResponseWriter, *http.Request)
+	MoveTeamMember(http.ResponseWriter, *http.Request)
+	SyncTeams(http.ResponseWriter, *http.Request)
+	ArchiveTeam(http.ResponseWriter, *http.Request)
+	DeleteTeam(http.ResponseWriter, *http.Request)
+	RenameTeam(http.ResponseWriter, *http.Request)
+	SetTeamParent(http.ResponseWriter, *http.Request)
+	GetTeamTree(http.ResponseWriter, *http.Request)
 }
 // UsersAPIRouter defines the required methods for binding the api requests to a responses for the UsersAPI
 // The UsersAPIRouter implementation should parse necessary information from the http request,
@@ -38,6 +56,11 @@ type TeamsAPIRouter interface {
 type UsersAPIRouter interface { 
 	UpdateActiveFlag(http.ResponseWriter, *http.Request)
 	GetPullRequestsByUser(http.ResponseWriter, *http.Request)
+	UpdateMaxOpenReviews(http.ResponseWriter, *http.Request)
+	AddUnavailability(http.ResponseWriter, *http.Request)
+	GetUnavailability(http.ResponseWriter, *http.Request)
+	CancelUnavailability(http.ResponseWriter, *http.Request)
+	UpdateWorkingHours(http.ResponseWriter, *http.Request)
 }
 
 
@@ -49,6 +72,13 @@ type PullRequestsAPIServicer interface {
 	CreatePullRequestAndAssign(context.Context, CreatePullRequestAndAssignRequest) (ImplResponse, error)
 	UpdateMergedFlag(context.Context, UpdateMergedFlagRequest) (ImplResponse, error)
 	ReassignUserOnPullRequest(context.Context, ReassignUserOnPullRequestRequest) (ImplResponse, error)
+	GetPullRequestHistory(context.Context, string) (ImplResponse, error)
+	SubmitReview(context.Context, SubmitReviewRequest) (ImplResponse, error)
+	ClosePullRequest(context.Context, ClosePullRequestRequest) (ImplResponse, error)
+	ReopenPullRequest(context.Context, ClosePullRequestRequest) (ImplResponse, error)
+	MarkPullRequestReady(context.Context, ClosePullRequestRequest) (ImplResponse, error)
+	GetPullRequest(context.Context, string) (ImplResponse, error)
+	ListPullRequests(context.Context, string, string, string, time.Time, time.Time, int32, string) (ImplResponse, error)
 }
 
 
@@ -57,8 +87,18 @@ type PullRequestsAPIServicer interface {
 // while the service implementation can be ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type TeamsAPIServicer interface { 
-	CreateTeam(context.Context, Team) (ImplResponse, error)
-	GetTeam(context.Context, string) (ImplResponse, error)
+	CreateTeam(context.Context, Team, bool, string) (ImplResponse, error)
+	GetTeam(context.Context, string, bool) (ImplResponse, error)
+	UpdateTeamSettings(context.Context, UpdateTeamSettingsRequest) (ImplResponse, error)
+	AddTeamMembers(context.Context, AddTeamMembersRequest) (ImplResponse, error)
+	RemoveTeamMember(context.Context, RemoveTeamMemberRequest) (ImplResponse, error)
+	MoveTeamMember(context.Context, MoveTeamMemberRequest) (ImplResponse, error)
+	SyncTeams(context.Context, SyncTeamsRequest, bool) (ImplResponse, error)
+	ArchiveTeam(context.Context, ArchiveTeamRequest) (ImplResponse, error)
+	DeleteTeam(context.Context, ArchiveTeamRequest) (ImplResponse, error)
+	RenameTeam(context.Context, RenameTeamRequest) (ImplResponse, error)
+	SetTeamParent(context.Context, SetTeamParentRequest) (ImplResponse, error)
+	GetTeamTree(context.Context) (ImplResponse, error)
 }
 
 
@@ -68,5 +108,10 @@ type TeamsAPIServicer interface {
 // and updated with the logic required for the API.
 type UsersAPIServicer interface { 
 	UpdateActiveFlag(context.Context, UpdateActiveFlagRequest) (ImplResponse, error)
-	GetPullRequestsByUser(context.Context, string) (ImplResponse, error)
+	GetPullRequestsByUser(context.Context, string, bool, string, time.Time, int32, string, bool) (ImplResponse, error)
+	UpdateMaxOpenReviews(context.Context, UpdateMaxOpenReviewsRequest) (ImplResponse, error)
+	AddUnavailability(context.Context, AddUnavailabilityRequest) (ImplResponse, error)
+	GetUnavailability(context.Context, string) (ImplResponse, error)
+	CancelUnavailability(context.Context, CancelUnavailabilityRequest) (ImplResponse, error)
+	UpdateWorkingHours(context.Context, UpdateWorkingHoursRequest) (ImplResponse, error)
 }
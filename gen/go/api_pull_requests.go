@@ -14,6 +14,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // PullRequestsAPIController binds http requests to an api service and writes the service results to the http response
@@ -67,6 +68,48 @@ func (c *PullRequestsAPIController) Routes() Routes {
 			"/pullRequest/reassign",
 			c.ReassignUserOnPullRequest,
 		},
+		"GetPullRequestHistory": Route{
+			"GetPullRequestHistory",
+			strings.ToUpper("Get"),
+			"/pullRequest/history",
+			c.GetPullRequestHistory,
+		},
+		"SubmitReview": Route{
+			"SubmitReview",
+			strings.ToUpper("Post"),
+			"/pullRequest/review",
+			c.SubmitReview,
+		},
+		"ClosePullRequest": Route{
+			"ClosePullRequest",
+			strings.ToUpper("post"),
+			"/pullRequest/close",
+			c.ClosePullRequest,
+		},
+		"ReopenPullRequest": Route{
+			"ReopenPullRequest",
+			strings.ToUpper("post"),
+			"/pullRequest/reopen",
+			c.ReopenPullRequest,
+		},
+		"MarkPullRequestReady": Route{
+			"MarkPullRequestReady",
+			strings.ToUpper("post"),
+			"/pullRequest/ready",
+			c.MarkPullRequestReady,
+		},
+		"GetPullRequest": Route{
+			"GetPullRequest",
+			strings.ToUpper("get"),
+			"/pullRequest/get",
+			c.GetPullRequest,
+		},
+		"ListPullRequests": Route{
+			"ListPullRequests",
+			strings.ToUpper("get"),
+			"/pullRequest/list",
+			c.ListPullRequests,
+		},
 	}
 }
 
@@ -91,12 +134,54 @@ func (c *PullRequestsAPIController) OrderedRoutes() []Route {
 			"/pullRequest/reassign",
 			c.ReassignUserOnPullRequest,
 		},
+		Route{
+			"GetPullRequestHistory",
+			strings.ToUpper("Get"),
+			"/pullRequest/history",
+			c.GetPullRequestHistory,
+		},
+		Route{
+			"SubmitReview",
+			strings.ToUpper("Post"),
+			"/pullRequest/review",
+			c.SubmitReview,
+		},
+		Route{
+			"ClosePullRequest",
+			strings.ToUpper("post"),
+			"/pullRequest/close",
+			c.ClosePullRequest,
+		},
+		Route{
+			"ReopenPullRequest",
+			strings.ToUpper("post"),
+			"/pullRequest/reopen",
+			c.ReopenPullRequest,
+		},
+		Route{
+			"MarkPullRequestReady",
+			strings.ToUpper("post"),
+			"/pullRequest/ready",
+			c.MarkPullRequestReady,
+		},
+		Route{
+			"GetPullRequest",
+			strings.ToUpper("get"),
+			"/pullRequest/get",
+			c.GetPullRequest,
+		},
+		Route{
+			"ListPullRequests",
+			strings.ToUpper("get"),
+			"/pullRequest/list",
+			c.ListPullRequests,
+		},
 	}
 }
 
 
 
-// CreatePullRequestAndAssign - Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// CreatePullRequestAndAssign - Создать PR и автоматически назначить ревьюверов из команды автора (для draft — без назначения)
 func (c *PullRequestsAPIController) CreatePullRequestAndAssign(w http.ResponseWriter, r *http.Request) {
 	var createPullRequestAndAssignRequestParam CreatePullRequestAndAssignRequest
 	d := json.NewDecoder(r.Body)
@@ -176,3 +261,248 @@ func (c *PullRequestsAPIController) ReassignUserOnPullRequest(w http.ResponseWri
 	// If no error, encode the body and the result code
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// GetPullRequestHistory - История назначений ревьюверов PR
+func (c *PullRequestsAPIController) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var pullRequestIdParam string
+	if query.Has("pull_request_id") {
+		param := query.Get("pull_request_id")
+
+		pullRequestIdParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "pull_request_id"}, nil)
+		return
+	}
+	result, err := c.service.GetPullRequestHistory(r.Context(), pullRequestIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// SubmitReview - Отправить вердикт назначенного ревьювера
+func (c *PullRequestsAPIController) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	var submitReviewRequestParam SubmitReviewRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&submitReviewRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertSubmitReviewRequestRequired(submitReviewRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertSubmitReviewRequestConstraints(submitReviewRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.SubmitReview(r.Context(), submitReviewRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ClosePullRequest - Закрыть PR без merge (из DRAFT или OPEN)
+func (c *PullRequestsAPIController) ClosePullRequest(w http.ResponseWriter, r *http.Request) {
+	var closePullRequestRequestParam ClosePullRequestRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertClosePullRequestRequestRequired(closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertClosePullRequestRequestConstraints(closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ClosePullRequest(r.Context(), closePullRequestRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ReopenPullRequest - Переоткрыть закрытый PR (возвращается в OPEN или, если закрыт из DRAFT, в DRAFT)
+func (c *PullRequestsAPIController) ReopenPullRequest(w http.ResponseWriter, r *http.Request) {
+	var closePullRequestRequestParam ClosePullRequestRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertClosePullRequestRequestRequired(closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertClosePullRequestRequestConstraints(closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ReopenPullRequest(r.Context(), closePullRequestRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// MarkPullRequestReady - Перевести DRAFT PR в OPEN и назначить ревьюверов по текущему составу команды
+func (c *PullRequestsAPIController) MarkPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	var closePullRequestRequestParam ClosePullRequestRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertClosePullRequestRequestRequired(closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertClosePullRequestRequestConstraints(closePullRequestRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.MarkPullRequestReady(r.Context(), closePullRequestRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetPullRequest - Получить PR с ревьюверами и вердиктами
+func (c *PullRequestsAPIController) GetPullRequest(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var pullRequestIdParam string
+	if query.Has("pull_request_id") {
+		param := query.Get("pull_request_id")
+
+		pullRequestIdParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "pull_request_id"}, nil)
+		return
+	}
+	result, err := c.service.GetPullRequest(r.Context(), pullRequestIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ListPullRequests - Список PR с фильтрами (от новых к старым, постраничный)
+func (c *PullRequestsAPIController) ListPullRequests(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var teamNameParam string
+	if query.Has("team_name") {
+		param := query.Get("team_name")
+
+		teamNameParam = param
+	} else {
+	}
+	var authorIdParam string
+	if query.Has("author_id") {
+		param := query.Get("author_id")
+
+		authorIdParam = param
+	} else {
+	}
+	var statusParam string
+	if query.Has("status") {
+		param := query.Get("status")
+
+		statusParam = param
+	} else {
+	}
+	var createdFromParam time.Time
+	if query.Has("created_from"){
+		param, err := parseTime(query.Get("created_from"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "created_from", Err: err}, nil)
+			return
+		}
+
+		createdFromParam = param
+	} else {
+	}
+	var createdToParam time.Time
+	if query.Has("created_to"){
+		param, err := parseTime(query.Get("created_to"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "created_to", Err: err}, nil)
+			return
+		}
+
+		createdToParam = param
+	} else {
+	}
+	var limitParam int32
+	if query.Has("limit") {
+		param, err := parseNumericParameter[int32](
+			query.Get("limit"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "limit", Err: err}, nil)
+			return
+		}
+
+		limitParam = param
+	} else {
+		var param int32 = 50
+		limitParam = param
+	}
+	var cursorParam string
+	if query.Has("cursor") {
+		param := query.Get("cursor")
+
+		cursorParam = param
+	} else {
+	}
+	result, err := c.service.ListPullRequests(r.Context(), teamNameParam, authorIdParam, statusParam, createdFromParam, createdToParam, limitParam, cursorParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
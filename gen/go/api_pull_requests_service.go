@@ -14,6 +14,7 @@ import (
 	"context"
 	"net/http"
 	"errors"
+	"time"
 )
 
 // PullRequestsAPIService is a service that implements the logic for the PullRequestsAPIServicer
@@ -27,7 +28,7 @@ func NewPullRequestsAPIService() *PullRequestsAPIService {
 	return &PullRequestsAPIService{}
 }
 
-// CreatePullRequestAndAssign - Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// CreatePullRequestAndAssign - Создать PR и автоматически назначить ревьюверов из команды автора (для draft — без назначения)
 func (s *PullRequestsAPIService) CreatePullRequestAndAssign(ctx context.Context, createPullRequestAndAssignRequest CreatePullRequestAndAssignRequest) (ImplResponse, error) {
 	// TODO - update CreatePullRequestAndAssign with the required logic for this service method.
 	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
@@ -35,6 +36,9 @@ func (s *PullRequestsAPIService) CreatePullRequestAndAssign(ctx context.Context,
 	// TODO: Uncomment the next line to return response Response(201, CreatePullRequestAndAssign201Response{}) or use other options such as http.Ok ...
 	// return Response(201, CreatePullRequestAndAssign201Response{}), nil
 
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
 	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
 	// return Response(404, ErrorResponse{}), nil
 
@@ -49,8 +53,8 @@ func (s *PullRequestsAPIService) UpdateMergedFlag(ctx context.Context, updateMer
 	// TODO - update UpdateMergedFlag with the required logic for this service method.
 	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
-	// TODO: Uncomment the next line to return response Response(200, CreatePullRequestAndAssign201Response{}) or use other options such as http.Ok ...
-	// return Response(200, CreatePullRequestAndAssign201Response{}), nil
+	// TODO: Uncomment the next line to return response Response(200, UpdateMergedFlag200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UpdateMergedFlag200Response{}), nil
 
 	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
 	// return Response(404, ErrorResponse{}), nil
@@ -74,3 +78,122 @@ func (s *PullRequestsAPIService) ReassignUserOnPullRequest(ctx context.Context,
 
 	return Response(http.StatusNotImplemented, nil), errors.New("ReassignUserOnPullRequest method not implemented")
 }
+
+// GetPullRequestHistory - История назначений ревьюверов PR
+func (s *PullRequestsAPIService) GetPullRequestHistory(ctx context.Context, pullRequestId string) (ImplResponse, error) {
+	// TODO - update GetPullRequestHistory with the required logic for this service method.
+	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, GetPullRequestHistory200Response{}) or use other options such as http.Ok ...
+	// return Response(200, GetPullRequestHistory200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetPullRequestHistory method not implemented")
+}
+
+// SubmitReview - Отправить вердикт назначенного ревьювера
+func (s *PullRequestsAPIService) SubmitReview(ctx context.Context, submitReviewRequest SubmitReviewRequest) (ImplResponse, error) {
+	// TODO - update SubmitReview with the required logic for this service method.
+	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UpdateMergedFlag200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UpdateMergedFlag200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("SubmitReview method not implemented")
+}
+
+// ClosePullRequest - Закрыть PR без merge (из DRAFT или OPEN)
+func (s *PullRequestsAPIService) ClosePullRequest(ctx context.Context, closePullRequestRequest ClosePullRequestRequest) (ImplResponse, error) {
+	// TODO - update ClosePullRequest with the required logic for this service method.
+	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UpdateMergedFlag200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UpdateMergedFlag200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ClosePullRequest method not implemented")
+}
+
+// ReopenPullRequest - Переоткрыть закрытый PR (возвращается в OPEN или, если закрыт из DRAFT, в DRAFT)
+func (s *PullRequestsAPIService) ReopenPullRequest(ctx context.Context, closePullRequestRequest ClosePullRequestRequest) (ImplResponse, error) {
+	// TODO - update ReopenPullRequest with the required logic for this service method.
+	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UpdateMergedFlag200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UpdateMergedFlag200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ReopenPullRequest method not implemented")
+}
+
+// MarkPullRequestReady - Перевести DRAFT PR в OPEN и назначить ревьюверов по текущему составу команды
+func (s *PullRequestsAPIService) MarkPullRequestReady(ctx context.Context, closePullRequestRequest ClosePullRequestRequest) (ImplResponse, error) {
+	// TODO - update MarkPullRequestReady with the required logic for this service method.
+	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CreatePullRequestAndAssign201Response{}) or use other options such as http.Ok ...
+	// return Response(200, CreatePullRequestAndAssign201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("MarkPullRequestReady method not implemented")
+}
+
+// GetPullRequest - Получить PR с ревьюверами и вердиктами
+func (s *PullRequestsAPIService) GetPullRequest(ctx context.Context, pullRequestId string) (ImplResponse, error) {
+	// TODO - update GetPullRequest with the required logic for this service method.
+	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UpdateMergedFlag200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UpdateMergedFlag200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetPullRequest method not implemented")
+}
+
+// ListPullRequests - Список PR с фильтрами (от новых к старым, постраничный)
+func (s *PullRequestsAPIService) ListPullRequests(ctx context.Context, teamName string, authorId string, status string, createdFrom time.Time, createdTo time.Time, limit int32, cursor string) (ImplResponse, error) {
+	// TODO - update ListPullRequests with the required logic for this service method.
+	// Add api_pull_requests_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, ListPullRequests200Response{}) or use other options such as http.Ok ...
+	// return Response(200, ListPullRequests200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ListPullRequests method not implemented")
+}
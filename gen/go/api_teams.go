@@ -61,6 +61,66 @@ func (c *TeamsAPIController) Routes() Routes {
 			"/team/get",
 			c.GetTeam,
 		},
+		"UpdateTeamSettings": Route{
+			"UpdateTeamSettings",
+			strings.ToUpper("Post"),
+			"/team/setSettings",
+			c.UpdateTeamSettings,
+		},
+		"AddTeamMembers": Route{
+			"AddTeamMembers",
+			strings.ToUpper("post"),
+			"/team/addMembers",
+			c.AddTeamMembers,
+		},
+		"RemoveTeamMember": Route{
+			"RemoveTeamMember",
+			strings.ToUpper("post"),
+			"/team/removeMember",
+			c.RemoveTeamMember,
+		},
+		"MoveTeamMember": Route{
+			"MoveTeamMember",
+			strings.ToUpper("post"),
+			"/team/moveMember",
+			c.MoveTeamMember,
+		},
+		"SyncTeams": Route{
+			"SyncTeams",
+			strings.ToUpper("Put"),
+			"/team/sync",
+			c.SyncTeams,
+		},
+		"ArchiveTeam": Route{
+			"ArchiveTeam",
+			strings.ToUpper("Post"),
+			"/team/archive",
+			c.ArchiveTeam,
+		},
+		"DeleteTeam": Route{
+			"DeleteTeam",
+			strings.ToUpper("Post"),
+			"/team/delete",
+			c.DeleteTeam,
+		},
+		"RenameTeam": Route{
+			"RenameTeam",
+			strings.ToUpper("Post"),
+			"/team/rename",
+			c.RenameTeam,
+		},
+		"SetTeamParent": Route{
+			"SetTeamParent",
+			strings.ToUpper("Post"),
+			"/team/setParent",
+			c.SetTeamParent,
+		},
+		"GetTeamTree": Route{
+			"GetTeamTree",
+			strings.ToUpper("Get"),
+			"/team/tree",
+			c.GetTeamTree,
+		},
 	}
 }
 
@@ -79,6 +139,66 @@ func (c *TeamsAPIController) OrderedRoutes() []Route {
 			"/team/get",
 			c.GetTeam,
 		},
+		Route{
+			"UpdateTeamSettings",
+			strings.ToUpper("Post"),
+			"/team/setSettings",
+			c.UpdateTeamSettings,
+		},
+		Route{
+			"AddTeamMembers",
+			strings.ToUpper("post"),
+			"/team/addMembers",
+			c.AddTeamMembers,
+		},
+		Route{
+			"RemoveTeamMember",
+			strings.ToUpper("post"),
+			"/team/removeMember",
+			c.RemoveTeamMember,
+		},
+		Route{
+			"MoveTeamMember",
+			strings.ToUpper("post"),
+			"/team/moveMember",
+			c.MoveTeamMember,
+		},
+		Route{
+			"SyncTeams",
+			strings.ToUpper("Put"),
+			"/team/sync",
+			c.SyncTeams,
+		},
+		Route{
+			"ArchiveTeam",
+			strings.ToUpper("Post"),
+			"/team/archive",
+			c.ArchiveTeam,
+		},
+		Route{
+			"DeleteTeam",
+			strings.ToUpper("Post"),
+			"/team/delete",
+			c.DeleteTeam,
+		},
+		Route{
+			"RenameTeam",
+			strings.ToUpper("Post"),
+			"/team/rename",
+			c.RenameTeam,
+		},
+		Route{
+			"SetTeamParent",
+			strings.ToUpper("Post"),
+			"/team/setParent",
+			c.SetTeamParent,
+		},
+		Route{
+			"GetTeamTree",
+			strings.ToUpper("Get"),
+			"/team/tree",
+			c.GetTeamTree,
+		},
 	}
 }
 
@@ -86,6 +206,11 @@ func (c *TeamsAPIController) OrderedRoutes() []Route {
 
 // CreateTeam - Создать команду с участниками (создаёт/обновляет пользователей)
 func (c *TeamsAPIController) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
 	var teamParam Team
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -101,7 +226,30 @@ func (c *TeamsAPIController) CreateTeam(w http.ResponseWriter, r *http.Request)
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.CreateTeam(r.Context(), teamParam)
+	var allowMoveParam bool
+	if query.Has("allow_move") {
+		param, err := parseBoolParameter(
+			query.Get("allow_move"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "allow_move", Err: err}, nil)
+			return
+		}
+
+		allowMoveParam = param
+	} else {
+		var param bool = false
+		allowMoveParam = param
+	}
+	var actorParam string
+	if query.Has("actor") {
+		param := query.Get("actor")
+
+		actorParam = param
+	} else {
+	}
+	result, err := c.service.CreateTeam(r.Context(), teamParam, allowMoveParam, actorParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -127,7 +275,299 @@ func (c *TeamsAPIController) GetTeam(w http.ResponseWriter, r *http.Request) {
 		c.errorHandler(w, r, &RequiredError{Field: "team_name"}, nil)
 		return
 	}
-	result, err := c.service.GetTeam(r.Context(), teamNameParam)
+	var includeSubTeamsParam bool
+	if query.Has("include_sub_teams") {
+		param, err := parseBoolParameter(
+			query.Get("include_sub_teams"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "include_sub_teams", Err: err}, nil)
+			return
+		}
+
+		includeSubTeamsParam = param
+	} else {
+		var param bool = false
+		includeSubTeamsParam = param
+	}
+	result, err := c.service.GetTeam(r.Context(), teamNameParam, includeSubTeamsParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateTeamSettings - Изменить настройки назначения ревьюверов команды
+func (c *TeamsAPIController) UpdateTeamSettings(w http.ResponseWriter, r *http.Request) {
+	var updateTeamSettingsRequestParam UpdateTeamSettingsRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&updateTeamSettingsRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertUpdateTeamSettingsRequestRequired(updateTeamSettingsRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertUpdateTeamSettingsRequestConstraints(updateTeamSettingsRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateTeamSettings(r.Context(), updateTeamSettingsRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// AddTeamMembers - Добавить участников в существующую команду (создаёт/обновляет пользователей)
+func (c *TeamsAPIController) AddTeamMembers(w http.ResponseWriter, r *http.Request) {
+	var addTeamMembersRequestParam AddTeamMembersRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&addTeamMembersRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertAddTeamMembersRequestRequired(addTeamMembersRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertAddTeamMembersRequestConstraints(addTeamMembersRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.AddTeamMembers(r.Context(), addTeamMembersRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// RemoveTeamMember - Исключить пользователя из команды (его открытые ревью переназначаются)
+func (c *TeamsAPIController) RemoveTeamMember(w http.ResponseWriter, r *http.Request) {
+	var removeTeamMemberRequestParam RemoveTeamMemberRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&removeTeamMemberRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertRemoveTeamMemberRequestRequired(removeTeamMemberRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertRemoveTeamMemberRequestConstraints(removeTeamMemberRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.RemoveTeamMember(r.Context(), removeTeamMemberRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// MoveTeamMember - Перевести пользователя в другую команду (ревью по PR прежней команды переназначаются)
+func (c *TeamsAPIController) MoveTeamMember(w http.ResponseWriter, r *http.Request) {
+	var moveTeamMemberRequestParam MoveTeamMemberRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&moveTeamMemberRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertMoveTeamMemberRequestRequired(moveTeamMemberRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertMoveTeamMemberRequestConstraints(moveTeamMemberRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.MoveTeamMember(r.Context(), moveTeamMemberRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// SyncTeams - Синхронизировать составы команд (создание, обновление, перевод и деактивация участников)
+func (c *TeamsAPIController) SyncTeams(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var syncTeamsRequestParam SyncTeamsRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&syncTeamsRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertSyncTeamsRequestRequired(syncTeamsRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertSyncTeamsRequestConstraints(syncTeamsRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var dryRunParam bool
+	if query.Has("dry_run") {
+		param, err := parseBoolParameter(
+			query.Get("dry_run"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "dry_run", Err: err}, nil)
+			return
+		}
+
+		dryRunParam = param
+	} else {
+		var param bool = false
+		dryRunParam = param
+	}
+	result, err := c.service.SyncTeams(r.Context(), syncTeamsRequestParam, dryRunParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ArchiveTeam - Архивировать команду (история доступна только для чтения, новые PR не создаются)
+func (c *TeamsAPIController) ArchiveTeam(w http.ResponseWriter, r *http.Request) {
+	var archiveTeamRequestParam ArchiveTeamRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&archiveTeamRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertArchiveTeamRequestRequired(archiveTeamRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertArchiveTeamRequestConstraints(archiveTeamRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ArchiveTeam(r.Context(), archiveTeamRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteTeam - Удалить команду без участников и PR
+func (c *TeamsAPIController) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	var archiveTeamRequestParam ArchiveTeamRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&archiveTeamRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertArchiveTeamRequestRequired(archiveTeamRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertArchiveTeamRequestConstraints(archiveTeamRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DeleteTeam(r.Context(), archiveTeamRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// RenameTeam - Переименовать команду (team_id сохраняется, прежнее имя временно остаётся псевдонимом)
+func (c *TeamsAPIController) RenameTeam(w http.ResponseWriter, r *http.Request) {
+	var renameTeamRequestParam RenameTeamRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&renameTeamRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertRenameTeamRequestRequired(renameTeamRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertRenameTeamRequestConstraints(renameTeamRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.RenameTeam(r.Context(), renameTeamRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// SetTeamParent - Переместить команду в дереве (настройки наследуются от нового родителя)
+func (c *TeamsAPIController) SetTeamParent(w http.ResponseWriter, r *http.Request) {
+	var setTeamParentRequestParam SetTeamParentRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&setTeamParentRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertSetTeamParentRequestRequired(setTeamParentRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertSetTeamParentRequestConstraints(setTeamParentRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.SetTeamParent(r.Context(), setTeamParentRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetTeamTree - Получить дерево всех команд
+func (c *TeamsAPIController) GetTeamTree(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.GetTeamTree(r.Context())
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
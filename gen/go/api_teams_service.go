@@ -28,7 +28,7 @@ func NewTeamsAPIService() *TeamsAPIService {
 }
 
 // CreateTeam - Создать команду с участниками (создаёт/обновляет пользователей)
-func (s *TeamsAPIService) CreateTeam(ctx context.Context, team Team) (ImplResponse, error) {
+func (s *TeamsAPIService) CreateTeam(ctx context.Context, team Team, allowMove bool, actor string) (ImplResponse, error) {
 	// TODO - update CreateTeam with the required logic for this service method.
 	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
@@ -38,11 +38,14 @@ func (s *TeamsAPIService) CreateTeam(ctx context.Context, team Team) (ImplRespon
 	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
 	// return Response(400, ErrorResponse{}), nil
 
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
 	return Response(http.StatusNotImplemented, nil), errors.New("CreateTeam method not implemented")
 }
 
 // GetTeam - Получить команду с участниками
-func (s *TeamsAPIService) GetTeam(ctx context.Context, teamName string) (ImplResponse, error) {
+func (s *TeamsAPIService) GetTeam(ctx context.Context, teamName string, includeSubTeams bool) (ImplResponse, error) {
 	// TODO - update GetTeam with the required logic for this service method.
 	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
@@ -54,3 +57,176 @@ func (s *TeamsAPIService) GetTeam(ctx context.Context, teamName string) (ImplRes
 
 	return Response(http.StatusNotImplemented, nil), errors.New("GetTeam method not implemented")
 }
+
+// UpdateTeamSettings - Изменить настройки назначения ревьюверов команды
+func (s *TeamsAPIService) UpdateTeamSettings(ctx context.Context, updateTeamSettingsRequest UpdateTeamSettingsRequest) (ImplResponse, error) {
+	// TODO - update UpdateTeamSettings with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CreateTeam201Response{}) or use other options such as http.Ok ...
+	// return Response(200, CreateTeam201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UpdateTeamSettings method not implemented")
+}
+
+// AddTeamMembers - Добавить участников в существующую команду (создаёт/обновляет пользователей)
+func (s *TeamsAPIService) AddTeamMembers(ctx context.Context, addTeamMembersRequest AddTeamMembersRequest) (ImplResponse, error) {
+	// TODO - update AddTeamMembers with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CreateTeam201Response{}) or use other options such as http.Ok ...
+	// return Response(200, CreateTeam201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AddTeamMembers method not implemented")
+}
+
+// RemoveTeamMember - Исключить пользователя из команды (его открытые ревью переназначаются)
+func (s *TeamsAPIService) RemoveTeamMember(ctx context.Context, removeTeamMemberRequest RemoveTeamMemberRequest) (ImplResponse, error) {
+	// TODO - update RemoveTeamMember with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, RemoveTeamMember200Response{}) or use other options such as http.Ok ...
+	// return Response(200, RemoveTeamMember200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("RemoveTeamMember method not implemented")
+}
+
+// MoveTeamMember - Перевести пользователя в другую команду (ревью по PR прежней команды переназначаются)
+func (s *TeamsAPIService) MoveTeamMember(ctx context.Context, moveTeamMemberRequest MoveTeamMemberRequest) (ImplResponse, error) {
+	// TODO - update MoveTeamMember with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UpdateActiveFlag200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UpdateActiveFlag200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("MoveTeamMember method not implemented")
+}
+
+// SyncTeams - Синхронизировать составы команд (создание, обновление, перевод и деактивация участников)
+func (s *TeamsAPIService) SyncTeams(ctx context.Context, syncTeamsRequest SyncTeamsRequest, dryRun bool) (ImplResponse, error) {
+	// TODO - update SyncTeams with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, SyncTeams200Response{}) or use other options such as http.Ok ...
+	// return Response(200, SyncTeams200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("SyncTeams method not implemented")
+}
+
+// ArchiveTeam - Архивировать команду (история доступна только для чтения, новые PR не создаются)
+func (s *TeamsAPIService) ArchiveTeam(ctx context.Context, archiveTeamRequest ArchiveTeamRequest) (ImplResponse, error) {
+	// TODO - update ArchiveTeam with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CreateTeam201Response{}) or use other options such as http.Ok ...
+	// return Response(200, CreateTeam201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ArchiveTeam method not implemented")
+}
+
+// DeleteTeam - Удалить команду без участников и PR
+func (s *TeamsAPIService) DeleteTeam(ctx context.Context, archiveTeamRequest ArchiveTeamRequest) (ImplResponse, error) {
+	// TODO - update DeleteTeam with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CreateTeam201Response{}) or use other options such as http.Ok ...
+	// return Response(200, CreateTeam201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteTeam method not implemented")
+}
+
+// RenameTeam - Переименовать команду (team_id сохраняется, прежнее имя временно остаётся псевдонимом)
+func (s *TeamsAPIService) RenameTeam(ctx context.Context, renameTeamRequest RenameTeamRequest) (ImplResponse, error) {
+	// TODO - update RenameTeam with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CreateTeam201Response{}) or use other options such as http.Ok ...
+	// return Response(200, CreateTeam201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("RenameTeam method not implemented")
+}
+
+// SetTeamParent - Переместить команду в дереве (настройки наследуются от нового родителя)
+func (s *TeamsAPIService) SetTeamParent(ctx context.Context, setTeamParentRequest SetTeamParentRequest) (ImplResponse, error) {
+	// TODO - update SetTeamParent with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CreateTeam201Response{}) or use other options such as http.Ok ...
+	// return Response(200, CreateTeam201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(409, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(409, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("SetTeamParent method not implemented")
+}
+
+// GetTeamTree - Получить дерево всех команд
+func (s *TeamsAPIService) GetTeamTree(ctx context.Context) (ImplResponse, error) {
+	// TODO - update GetTeamTree with the required logic for this service method.
+	// Add api_teams_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, GetTeamTree200Response{}) or use other options such as http.Ok ...
+	// return Response(200, GetTeamTree200Response{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetTeamTree method not implemented")
+}
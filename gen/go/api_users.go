@@ -14,6 +14,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // UsersAPIController binds http requests to an api service and writes the service results to the http response
@@ -61,6 +62,36 @@ func (c *UsersAPIController) Routes() Routes {
 			"/users/getReview",
 			c.GetPullRequestsByUser,
 		},
+		"UpdateMaxOpenReviews": Route{
+			"UpdateMaxOpenReviews",
+			strings.ToUpper("Post"),
+			"/users/setMaxOpenReviews",
+			c.UpdateMaxOpenReviews,
+		},
+		"AddUnavailability": Route{
+			"AddUnavailability",
+			strings.ToUpper("Post"),
+			"/users/addUnavailability",
+			c.AddUnavailability,
+		},
+		"GetUnavailability": Route{
+			"GetUnavailability",
+			strings.ToUpper("Get"),
+			"/users/getUnavailability",
+			c.GetUnavailability,
+		},
+		"CancelUnavailability": Route{
+			"CancelUnavailability",
+			strings.ToUpper("Post"),
+			"/users/cancelUnavailability",
+			c.CancelUnavailability,
+		},
+		"UpdateWorkingHours": Route{
+			"UpdateWorkingHours",
+			strings.ToUpper("Post"),
+			"/users/setWorkingHours",
+			c.UpdateWorkingHours,
+		},
 	}
 }
 
@@ -79,12 +110,42 @@ func (c *UsersAPIController) OrderedRoutes() []Route {
 			"/users/getReview",
 			c.GetPullRequestsByUser,
 		},
+		Route{
+			"UpdateMaxOpenReviews",
+			strings.ToUpper("Post"),
+			"/users/setMaxOpenReviews",
+			c.UpdateMaxOpenReviews,
+		},
+		Route{
+			"AddUnavailability",
+			strings.ToUpper("Post"),
+			"/users/addUnavailability",
+			c.AddUnavailability,
+		},
+		Route{
+			"GetUnavailability",
+			strings.ToUpper("Get"),
+			"/users/getUnavailability",
+			c.GetUnavailability,
+		},
+		Route{
+			"CancelUnavailability",
+			strings.ToUpper("Post"),
+			"/users/cancelUnavailability",
+			c.CancelUnavailability,
+		},
+		Route{
+			"UpdateWorkingHours",
+			strings.ToUpper("Post"),
+			"/users/setWorkingHours",
+			c.UpdateWorkingHours,
+		},
 	}
 }
 
 
 
-// UpdateActiveFlag - Установить флаг активности пользователя
+// UpdateActiveFlag - Установить флаг активности пользователя (при деактивации открытые ревью переназначаются)
 func (c *UsersAPIController) UpdateActiveFlag(w http.ResponseWriter, r *http.Request) {
 	var updateActiveFlagRequestParam UpdateActiveFlagRequest
 	d := json.NewDecoder(r.Body)
@@ -127,7 +188,216 @@ func (c *UsersAPIController) GetPullRequestsByUser(w http.ResponseWriter, r *htt
 		c.errorHandler(w, r, &RequiredError{Field: "user_id"}, nil)
 		return
 	}
-	result, err := c.service.GetPullRequestsByUser(r.Context(), userIdParam)
+	var pendingOnlyParam bool
+	if query.Has("pending_only") {
+		param, err := parseBoolParameter(
+			query.Get("pending_only"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pending_only", Err: err}, nil)
+			return
+		}
+
+		pendingOnlyParam = param
+	} else {
+		var param bool = false
+		pendingOnlyParam = param
+	}
+	var statusParam string
+	if query.Has("status") {
+		param := query.Get("status")
+
+		statusParam = param
+	} else {
+	}
+	var sinceParam time.Time
+	if query.Has("since"){
+		param, err := parseTime(query.Get("since"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "since", Err: err}, nil)
+			return
+		}
+
+		sinceParam = param
+	} else {
+	}
+	var limitParam int32
+	if query.Has("limit") {
+		param, err := parseNumericParameter[int32](
+			query.Get("limit"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "limit", Err: err}, nil)
+			return
+		}
+
+		limitParam = param
+	} else {
+		var param int32 = 50
+		limitParam = param
+	}
+	var cursorParam string
+	if query.Has("cursor") {
+		param := query.Get("cursor")
+
+		cursorParam = param
+	} else {
+	}
+	var fullParam bool
+	if query.Has("full") {
+		param, err := parseBoolParameter(
+			query.Get("full"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "full", Err: err}, nil)
+			return
+		}
+
+		fullParam = param
+	} else {
+		var param bool = false
+		fullParam = param
+	}
+	result, err := c.service.GetPullRequestsByUser(r.Context(), userIdParam, pendingOnlyParam, statusParam, sinceParam, limitParam, cursorParam, fullParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateMaxOpenReviews - Установить личный лимит открытых ревью пользователя
+func (c *UsersAPIController) UpdateMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var updateMaxOpenReviewsRequestParam UpdateMaxOpenReviewsRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&updateMaxOpenReviewsRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertUpdateMaxOpenReviewsRequestRequired(updateMaxOpenReviewsRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertUpdateMaxOpenReviewsRequestConstraints(updateMaxOpenReviewsRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateMaxOpenReviews(r.Context(), updateMaxOpenReviewsRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// AddUnavailability - Запланировать период недоступности пользователя (отпуск, out-of-office)
+func (c *UsersAPIController) AddUnavailability(w http.ResponseWriter, r *http.Request) {
+	var addUnavailabilityRequestParam AddUnavailabilityRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&addUnavailabilityRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertAddUnavailabilityRequestRequired(addUnavailabilityRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertAddUnavailabilityRequestConstraints(addUnavailabilityRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.AddUnavailability(r.Context(), addUnavailabilityRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetUnavailability - Получить периоды недоступности пользователя
+func (c *UsersAPIController) GetUnavailability(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var userIdParam string
+	if query.Has("user_id") {
+		param := query.Get("user_id")
+
+		userIdParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "user_id"}, nil)
+		return
+	}
+	result, err := c.service.GetUnavailability(r.Context(), userIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CancelUnavailability - Отменить период недоступности
+func (c *UsersAPIController) CancelUnavailability(w http.ResponseWriter, r *http.Request) {
+	var cancelUnavailabilityRequestParam CancelUnavailabilityRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&cancelUnavailabilityRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCancelUnavailabilityRequestRequired(cancelUnavailabilityRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCancelUnavailabilityRequestConstraints(cancelUnavailabilityRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CancelUnavailability(r.Context(), cancelUnavailabilityRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateWorkingHours - Установить часовой пояс и рабочие часы пользователя
+func (c *UsersAPIController) UpdateWorkingHours(w http.ResponseWriter, r *http.Request) {
+	var updateWorkingHoursRequestParam UpdateWorkingHoursRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&updateWorkingHoursRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertUpdateWorkingHoursRequestRequired(updateWorkingHoursRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertUpdateWorkingHoursRequestConstraints(updateWorkingHoursRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateWorkingHours(r.Context(), updateWorkingHoursRequestParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
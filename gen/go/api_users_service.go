@@ -14,6 +14,7 @@ import (
 	"context"
 	"net/http"
 	"errors"
+	"time"
 )
 
 // UsersAPIService is a service that implements the logic for the UsersAPIServicer
@@ -27,7 +28,7 @@ func NewUsersAPIService() *UsersAPIService {
 	return &UsersAPIService{}
 }
 
-// UpdateActiveFlag - Установить флаг активности пользователя
+// UpdateActiveFlag - Установить флаг активности пользователя (при деактивации открытые ревью переназначаются)
 func (s *UsersAPIService) UpdateActiveFlag(ctx context.Context, updateActiveFlagRequest UpdateActiveFlagRequest) (ImplResponse, error) {
 	// TODO - update UpdateActiveFlag with the required logic for this service method.
 	// Add api_users_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
@@ -42,12 +43,97 @@ func (s *UsersAPIService) UpdateActiveFlag(ctx context.Context, updateActiveFlag
 }
 
 // GetPullRequestsByUser - Получить PR&#39;ы, где пользователь назначен ревьювером
-func (s *UsersAPIService) GetPullRequestsByUser(ctx context.Context, userId string) (ImplResponse, error) {
+func (s *UsersAPIService) GetPullRequestsByUser(ctx context.Context, userId string, pendingOnly bool, status string, since time.Time, limit int32, cursor string, full bool) (ImplResponse, error) {
 	// TODO - update GetPullRequestsByUser with the required logic for this service method.
 	// Add api_users_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
 	// TODO: Uncomment the next line to return response Response(200, GetPullRequestsByUser200Response{}) or use other options such as http.Ok ...
 	// return Response(200, GetPullRequestsByUser200Response{}), nil
 
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
 	return Response(http.StatusNotImplemented, nil), errors.New("GetPullRequestsByUser method not implemented")
 }
+
+// UpdateMaxOpenReviews - Установить личный лимит открытых ревью пользователя
+func (s *UsersAPIService) UpdateMaxOpenReviews(ctx context.Context, updateMaxOpenReviewsRequest UpdateMaxOpenReviewsRequest) (ImplResponse, error) {
+	// TODO - update UpdateMaxOpenReviews with the required logic for this service method.
+	// Add api_users_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UpdateMaxOpenReviews200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UpdateMaxOpenReviews200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UpdateMaxOpenReviews method not implemented")
+}
+
+// AddUnavailability - Запланировать период недоступности пользователя (отпуск, out-of-office)
+func (s *UsersAPIService) AddUnavailability(ctx context.Context, addUnavailabilityRequest AddUnavailabilityRequest) (ImplResponse, error) {
+	// TODO - update AddUnavailability with the required logic for this service method.
+	// Add api_users_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(201, AddUnavailability201Response{}) or use other options such as http.Ok ...
+	// return Response(201, AddUnavailability201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AddUnavailability method not implemented")
+}
+
+// GetUnavailability - Получить периоды недоступности пользователя
+func (s *UsersAPIService) GetUnavailability(ctx context.Context, userId string) (ImplResponse, error) {
+	// TODO - update GetUnavailability with the required logic for this service method.
+	// Add api_users_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, GetUnavailability200Response{}) or use other options such as http.Ok ...
+	// return Response(200, GetUnavailability200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetUnavailability method not implemented")
+}
+
+// CancelUnavailability - Отменить период недоступности
+func (s *UsersAPIService) CancelUnavailability(ctx context.Context, cancelUnavailabilityRequest CancelUnavailabilityRequest) (ImplResponse, error) {
+	// TODO - update CancelUnavailability with the required logic for this service method.
+	// Add api_users_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, AddUnavailability201Response{}) or use other options such as http.Ok ...
+	// return Response(200, AddUnavailability201Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CancelUnavailability method not implemented")
+}
+
+// UpdateWorkingHours - Установить часовой пояс и рабочие часы пользователя
+func (s *UsersAPIService) UpdateWorkingHours(ctx context.Context, updateWorkingHoursRequest UpdateWorkingHoursRequest) (ImplResponse, error) {
+	// TODO - update UpdateWorkingHours with the required logic for this service method.
+	// Add api_users_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, UpdateMaxOpenReviews200Response{}) or use other options such as http.Ok ...
+	// return Response(200, UpdateMaxOpenReviews200Response{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(400, ErrorResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, ErrorResponse{}) or use other options such as http.Ok ...
+	// return Response(404, ErrorResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UpdateWorkingHours method not implemented")
+}
@@ -15,11 +15,27 @@ package openapi
 
 type CreatePullRequestAndAssign201Response struct {
 
-	Pr PullRequest `json:"pr,omitempty"`
+	Pr PullRequest `json:"pr"`
+
+	// true, если назначено меньше ревьюверов, чем min_reviewers команды
+	Understaffed bool `json:"understaffed"`
+
+	MinReviewers int32 `json:"min_reviewers"`
 }
 
 // AssertCreatePullRequestAndAssign201ResponseRequired checks if the required fields are not zero-ed
 func AssertCreatePullRequestAndAssign201ResponseRequired(obj CreatePullRequestAndAssign201Response) error {
+	elements := map[string]interface{}{
+		"pr": obj.Pr,
+		"understaffed": obj.Understaffed,
+		"min_reviewers": obj.MinReviewers,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
 	if err := AssertPullRequestRequired(obj.Pr); err != nil {
 		return err
 	}
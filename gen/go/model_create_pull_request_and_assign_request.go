@@ -20,6 +20,12 @@ type CreatePullRequestAndAssignRequest struct {
 	PullRequestName string `json:"pull_request_name"`
 
 	AuthorId string `json:"author_id"`
+
+	// Сколько ревьюверов назначить (в пределах min_reviewers..max_reviewers команды и не меньше required_approvals, по умолчанию max_reviewers)
+	ReviewersCount int32 `json:"reviewers_count,omitempty"`
+
+	// Создать PR в состоянии DRAFT без назначения ревьюверов (назначение — через /pullRequest/ready)
+	Draft bool `json:"draft,omitempty"`
 }
 
 // AssertCreatePullRequestAndAssignRequestRequired checks if the required fields are not zero-ed
@@ -17,13 +17,28 @@ type GetPullRequestsByUser200Response struct {
 
 	UserId string `json:"user_id"`
 
+	TeamName string `json:"team_name"`
+
+	// Стабильный идентификатор команды (null — не состоит в команде)
+	TeamId *int64 `json:"team_id,omitempty"`
+
+	IsActive bool `json:"is_active"`
+
 	PullRequests []PullRequestShort `json:"pull_requests"`
+
+	// Те же PR в полном виде (только при full=true)
+	PullRequestsFull []PullRequest `json:"pull_requests_full,omitempty"`
+
+	// Курсор следующей страницы (отсутствует на последней)
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // AssertGetPullRequestsByUser200ResponseRequired checks if the required fields are not zero-ed
 func AssertGetPullRequestsByUser200ResponseRequired(obj GetPullRequestsByUser200Response) error {
 	elements := map[string]interface{}{
 		"user_id": obj.UserId,
+		"team_name": obj.TeamName,
+		"is_active": obj.IsActive,
 		"pull_requests": obj.PullRequests,
 	}
 	for name, el := range elements {
@@ -37,6 +52,11 @@ func AssertGetPullRequestsByUser200ResponseRequired(obj GetPullRequestsByUser200
 			return err
 		}
 	}
+	for _, el := range obj.PullRequestsFull {
+		if err := AssertPullRequestRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -47,5 +67,10 @@ func AssertGetPullRequestsByUser200ResponseConstraints(obj GetPullRequestsByUser
 			return err
 		}
 	}
+	for _, el := range obj.PullRequestsFull {
+		if err := AssertPullRequestConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
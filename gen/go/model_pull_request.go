@@ -27,12 +27,27 @@ type PullRequest struct {
 
 	Status string `json:"status"`
 
-	// user_id назначенных ревьюверов (0..2)
+	// user_id назначенных ревьюверов (0..max_reviewers команды)
 	AssignedReviewers []string `json:"assigned_reviewers"`
 
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
+	// Когда PR последний раз переведён в DRAFT
+	DraftedAt *time.Time `json:"draftedAt,omitempty"`
+
+	// Когда PR последний раз переведён в OPEN
+	OpenedAt *time.Time `json:"openedAt,omitempty"`
+
 	MergedAt *time.Time `json:"mergedAt,omitempty"`
+
+	// Когда PR последний раз переведён в CLOSED
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// Ревьюверы из assigned_reviewers, назначенные из резервных команд
+	FallbackReviewers []FallbackReviewer `json:"fallback_reviewers,omitempty"`
+
+	// Вердикты назначенных ревьюверов
+	Reviews []ReviewVerdict `json:"reviews,omitempty"`
 }
 
 // AssertPullRequestRequired checks if the required fields are not zero-ed
@@ -50,10 +65,30 @@ func AssertPullRequestRequired(obj PullRequest) error {
 		}
 	}
 
+	for _, el := range obj.FallbackReviewers {
+		if err := AssertFallbackReviewerRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Reviews {
+		if err := AssertReviewVerdictRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertPullRequestConstraints checks if the values respects the defined constraints
 func AssertPullRequestConstraints(obj PullRequest) error {
+	for _, el := range obj.FallbackReviewers {
+		if err := AssertFallbackReviewerConstraints(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Reviews {
+		if err := AssertReviewVerdictConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
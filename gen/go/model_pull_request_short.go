@@ -11,6 +11,10 @@
 package openapi
 
 
+import (
+	"time"
+)
+
 
 
 type PullRequestShort struct {
@@ -22,6 +26,8 @@ type PullRequestShort struct {
 	AuthorId string `json:"author_id"`
 
 	Status string `json:"status"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 }
 
 // AssertPullRequestShortRequired checks if the required fields are not zero-ed
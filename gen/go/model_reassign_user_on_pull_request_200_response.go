@@ -19,6 +19,9 @@ type ReassignUserOnPullRequest200Response struct {
 
 	// user_id нового ревьювера
 	ReplacedBy string `json:"replaced_by"`
+
+	// Новый ревьювер ранее снимался с этого PR: других доступных кандидатов не было
+	ExclusionRelaxed bool `json:"exclusion_relaxed"`
 }
 
 // AssertReassignUserOnPullRequest200ResponseRequired checks if the required fields are not zero-ed
@@ -26,6 +29,7 @@ func AssertReassignUserOnPullRequest200ResponseRequired(obj ReassignUserOnPullRe
 	elements := map[string]interface{}{
 		"pr": obj.Pr,
 		"replaced_by": obj.ReplacedBy,
+		"exclusion_relaxed": obj.ExclusionRelaxed,
 	}
 	for name, el := range elements {
 		if isZero := IsZeroValue(el); isZero {
@@ -18,6 +18,15 @@ type ReassignUserOnPullRequestRequest struct {
 	PullRequestId string `json:"pull_request_id"`
 
 	OldUserId string `json:"old_user_id"`
+
+	// Явно выбранный новый ревьювер (активный участник команды PR, не автор и не назначенный); без него кандидат выбирается автоматически
+	NewUserId string `json:"new_user_id,omitempty"`
+
+	// Кто выполняет переназначение (попадает в историю)
+	Actor string `json:"actor,omitempty"`
+
+	// Причина переназначения (попадает в историю)
+	Reason string `json:"reason,omitempty"`
 }
 
 // AssertReassignUserOnPullRequestRequestRequired checks if the required fields are not zero-ed
@@ -11,13 +11,34 @@
 package openapi
 
 
+import (
+	"time"
+)
+
 
 
 type Team struct {
 
 	TeamName string `json:"team_name"`
 
+	// Стабильный идентификатор команды (не меняется при переименовании)
+	TeamId int64 `json:"team_id,omitempty"`
+
 	Members []TeamMember `json:"members"`
+
+	Settings TeamSettings `json:"settings,omitempty"`
+
+	// Время архивации (null — команда активна)
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// Родительская команда (null — команда верхнего уровня)
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+
+	// Стабильный идентификатор родительской команды
+	ParentTeamId *int64 `json:"parent_team_id,omitempty"`
+
+	// Дерево подкоманд (только в /team/get с include_sub_teams=true)
+	SubTeams []TeamTreeNode `json:"sub_teams,omitempty"`
 }
 
 // AssertTeamRequired checks if the required fields are not zero-ed
@@ -37,6 +58,14 @@ func AssertTeamRequired(obj Team) error {
 			return err
 		}
 	}
+	if err := AssertTeamSettingsRequired(obj.Settings); err != nil {
+		return err
+	}
+	for _, el := range obj.SubTeams {
+		if err := AssertTeamTreeNodeRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -47,5 +76,13 @@ func AssertTeamConstraints(obj Team) error {
 			return err
 		}
 	}
+	if err := AssertTeamSettingsConstraints(obj.Settings); err != nil {
+		return err
+	}
+	for _, el := range obj.SubTeams {
+		if err := AssertTeamTreeNodeConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -20,6 +20,9 @@ type TeamMember struct {
 	Username string `json:"username"`
 
 	IsActive bool `json:"is_active"`
+
+	// Личный лимит открытых ревью (null — лимит команды)
+	MaxOpenReviews *int32 `json:"max_open_reviews,omitempty"`
 }
 
 // AssertTeamMemberRequired checks if the required fields are not zero-ed
@@ -16,6 +16,12 @@ package openapi
 type UpdateActiveFlag200Response struct {
 
 	User User `json:"user,omitempty"`
+
+	// Открытые ревью, переданные другим ревьюверам
+	ReassignedReviews []ReviewReassignment `json:"reassigned_reviews,omitempty"`
+
+	// pull_request_id открытых ревью, для которых не нашлось кандидата
+	UnreassignedReviews []string `json:"unreassigned_reviews,omitempty"`
 }
 
 // AssertUpdateActiveFlag200ResponseRequired checks if the required fields are not zero-ed
@@ -23,6 +29,11 @@ func AssertUpdateActiveFlag200ResponseRequired(obj UpdateActiveFlag200Response)
 	if err := AssertUserRequired(obj.User); err != nil {
 		return err
 	}
+	for _, el := range obj.ReassignedReviews {
+		if err := AssertReviewReassignmentRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -31,5 +42,10 @@ func AssertUpdateActiveFlag200ResponseConstraints(obj UpdateActiveFlag200Respons
 	if err := AssertUserConstraints(obj.User); err != nil {
 		return err
 	}
+	for _, el := range obj.ReassignedReviews {
+		if err := AssertReviewReassignmentConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
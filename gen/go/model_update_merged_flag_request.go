@@ -16,6 +16,15 @@ package openapi
 type UpdateMergedFlagRequest struct {
 
 	PullRequestId string `json:"pull_request_id"`
+
+	// Выполнить merge вопреки политике команды (требует reason, попадает в аудит)
+	Force bool `json:"force,omitempty"`
+
+	// Причина принудительного merge
+	Reason string `json:"reason,omitempty"`
+
+	// Кто выполняет merge
+	Actor string `json:"actor,omitempty"`
 }
 
 // AssertUpdateMergedFlagRequestRequired checks if the required fields are not zero-ed
@@ -21,7 +21,16 @@ type User struct {
 
 	TeamName string `json:"team_name"`
 
+	// Стабильный идентификатор команды (null — не состоит в команде)
+	TeamId *int64 `json:"team_id,omitempty"`
+
 	IsActive bool `json:"is_active"`
+
+	// Личный лимит открытых ревью (null — лимит команды)
+	MaxOpenReviews *int32 `json:"max_open_reviews,omitempty"`
+
+	// Рабочие часы (null — расписание не задано)
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
 }
 
 // AssertUserRequired checks if the required fields are not zero-ed
@@ -38,10 +47,20 @@ func AssertUserRequired(obj User) error {
 		}
 	}
 
+	if obj.WorkingHours != nil {
+		if err := AssertWorkingHoursRequired(*obj.WorkingHours); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertUserConstraints checks if the values respects the defined constraints
 func AssertUserConstraints(obj User) error {
+	if obj.WorkingHours != nil {
+		if err := AssertWorkingHoursConstraints(*obj.WorkingHours); err != nil {
+			return err
+		}
+	}
 	return nil
 }
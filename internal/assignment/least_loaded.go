@@ -0,0 +1,20 @@
+package assignment
+
+import "sort"
+
+// LeastLoaded prefers candidates with the fewest open reviews, breaking ties
+// randomly.
+type LeastLoaded struct {
+	rng *lockedRand
+}
+
+func (LeastLoaded) Name() string { return StrategyLeastLoaded }
+
+func (s LeastLoaded) Select(req Request) []string {
+	candidates := append([]Candidate(nil), req.Candidates...)
+	s.rng.shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].OpenReviews < candidates[j].OpenReviews
+	})
+	return limit(candidateIDs(candidates), req.Count)
+}
@@ -0,0 +1,14 @@
+package assignment
+
+// Random picks reviewers uniformly at random.
+type Random struct {
+	rng *lockedRand
+}
+
+func (Random) Name() string { return StrategyRandom }
+
+func (s Random) Select(req Request) []string {
+	ids := candidateIDs(req.Candidates)
+	s.rng.shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	return limit(ids, req.Count)
+}
@@ -0,0 +1,22 @@
+package assignment
+
+import "sort"
+
+// RoundRobin walks the candidates in user id order, starting right after
+// Request.Cursor and wrapping around.
+type RoundRobin struct{}
+
+func (RoundRobin) Name() string { return StrategyRoundRobin }
+
+func (RoundRobin) Select(req Request) []string {
+	ids := candidateIDs(req.Candidates)
+	sort.Strings(ids)
+	start := sort.SearchStrings(ids, req.Cursor)
+	if start < len(ids) && ids[start] == req.Cursor {
+		start++
+	}
+	rotated := make([]string, 0, len(ids))
+	rotated = append(rotated, ids[start:]...)
+	rotated = append(rotated, ids[:start]...)
+	return limit(rotated, req.Count)
+}
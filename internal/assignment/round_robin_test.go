@@ -0,0 +1,88 @@
+package assignment
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRoundRobinSelect(t *testing.T) {
+	candidates := func(ids ...string) []Candidate {
+		out := make([]Candidate, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, Candidate{UserID: id})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		candidates []Candidate
+		cursor     string
+		count      int
+		want       []string
+	}{
+		{
+			name:       "no cursor starts at the lowest id",
+			candidates: candidates("u3", "u1", "u2"),
+			count:      2,
+			want:       []string{"u1", "u2"},
+		},
+		{
+			name:       "continues after the cursor",
+			candidates: candidates("u1", "u2", "u3", "u4"),
+			cursor:     "u2",
+			count:      2,
+			want:       []string{"u3", "u4"},
+		},
+		{
+			name:       "wraps around after the last id",
+			candidates: candidates("u1", "u2", "u3"),
+			cursor:     "u2",
+			count:      2,
+			want:       []string{"u3", "u1"},
+		},
+		{
+			name:       "cursor on the last id wraps to the first",
+			candidates: candidates("u1", "u2", "u3"),
+			cursor:     "u3",
+			count:      1,
+			want:       []string{"u1"},
+		},
+		{
+			name:       "cursor missing from the candidates continues at the next id",
+			candidates: candidates("u1", "u3", "u5"),
+			cursor:     "u2",
+			count:      2,
+			want:       []string{"u3", "u5"},
+		},
+		{
+			name:       "cursor after every candidate wraps to the first",
+			candidates: candidates("u1", "u3"),
+			cursor:     "u9",
+			count:      1,
+			want:       []string{"u1"},
+		},
+		{
+			name:       "count above the number of candidates returns everyone once",
+			candidates: candidates("u1", "u2", "u3"),
+			cursor:     "u1",
+			count:      5,
+			want:       []string{"u2", "u3", "u1"},
+		},
+		{
+			name:   "no candidates",
+			cursor: "u1",
+			count:  2,
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RoundRobin{}.Select(Request{Candidates: tt.candidates, Cursor: tt.cursor, Count: tt.count})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Select() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Package assignment contains the policies used to choose pull request
+// reviewers from a team's candidate pool.
+package assignment
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const (
+	StrategyRandom      = "random"
+	StrategyRoundRobin  = "round_robin"
+	StrategyLeastLoaded = "least_loaded"
+
+	DefaultStrategy = StrategyLeastLoaded
+)
+
+// Candidate is a user eligible to review a pull request.
+type Candidate struct {
+	UserID      string
+	OpenReviews int
+}
+
+// Request describes a single reviewer selection.
+type Request struct {
+	PullRequestID string
+	AuthorID      string
+	Candidates    []Candidate
+	Count         int
+	// Cursor is the last user handed a review by the team's rotation.
+	// Rotating strategies continue after it.
+	Cursor string
+}
+
+// Strategy orders candidates by preference and returns at most Count of them.
+type Strategy interface {
+	Name() string
+	Select(req Request) []string
+}
+
+// Registry maps strategy names to their implementations.
+type Registry map[string]Strategy
+
+// Builtin returns a registry with the random, round-robin and least-loaded
+// strategies.
+func Builtin() Registry {
+	rng := newLockedRand()
+	return Registry{
+		StrategyRandom:      Random{rng: rng},
+		StrategyRoundRobin:  RoundRobin{},
+		StrategyLeastLoaded: LeastLoaded{rng: rng},
+	}
+}
+
+// Has reports whether name refers to a registered strategy.
+func (r Registry) Has(name string) bool {
+	_, ok := r[name]
+	return ok
+}
+
+// Get returns the named strategy, falling back to DefaultStrategy.
+func (r Registry) Get(name string) Strategy {
+	if s, ok := r[name]; ok {
+		return s
+	}
+	return r[DefaultStrategy]
+}
+
+func limit(ids []string, count int) []string {
+	if count < len(ids) {
+		return ids[:count]
+	}
+	return ids
+}
+
+func candidateIDs(candidates []Candidate) []string {
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.UserID)
+	}
+	return ids
+}
+
+type lockedRand struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func newLockedRand() *lockedRand {
+	return &lockedRand{rng: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+func (l *lockedRand) shuffle(n int, swap func(i, j int)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rng.Shuffle(n, swap)
+}
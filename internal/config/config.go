@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 type Config struct {
@@ -14,6 +15,12 @@ type Config struct {
 	DBPass    string
 	DBName    string
 	DBSSLMode string
+	// ReactivationInterval controls how often users are turned back on after
+	// their unavailability period ends; zero disables the job.
+	ReactivationInterval time.Duration
+	// TeamAliasGracePeriod is how long the former name of a renamed team keeps
+	// resolving to it; zero drops the old name right away.
+	TeamAliasGracePeriod time.Duration
 }
 
 func Load() Config {
@@ -25,6 +32,9 @@ func Load() Config {
 		DBPass:    strFromEnv("DB_PASSWORD", "app"),
 		DBName:    strFromEnv("DB_NAME", "pr_assignments"),
 		DBSSLMode: strFromEnv("DB_SSLMODE", "disable"),
+
+		ReactivationInterval: durationFromEnv("REACTIVATION_INTERVAL", 0),
+		TeamAliasGracePeriod: durationFromEnv("TEAM_ALIAS_GRACE_PERIOD", 30*24*time.Hour),
 	}
 }
 
@@ -58,3 +68,12 @@ func intFromEnv(key string, fallback int) int {
 	}
 	return fallback
 }
+
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	if val := os.Getenv(key); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
@@ -16,7 +16,7 @@ var schemaStatements = []string{
 		id TEXT PRIMARY KEY,
 		username TEXT NOT NULL,
 		is_active BOOLEAN NOT NULL DEFAULT TRUE,
-		team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+		team_id INTEGER REFERENCES teams(id) ON DELETE RESTRICT,
 		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 	)`,
 	`CREATE TABLE IF NOT EXISTS pull_requests (
@@ -24,7 +24,7 @@ var schemaStatements = []string{
 		name TEXT NOT NULL,
 		author_id TEXT NOT NULL REFERENCES users(id),
 		team_id INTEGER NOT NULL REFERENCES teams(id),
-		status TEXT NOT NULL CHECK (status IN ('OPEN','MERGED')),
+		status TEXT NOT NULL CHECK (status IN ('DRAFT','OPEN','MERGED','CLOSED')),
 		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 		merged_at TIMESTAMPTZ
 	)`,
@@ -34,9 +34,137 @@ var schemaStatements = []string{
 		assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 		PRIMARY KEY (pull_request_id, reviewer_id)
 	)`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS assignment_strategy TEXT`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS min_reviewers INTEGER`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS max_reviewers INTEGER`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS capacity_overflow TEXT`,
+	`ALTER TABLE users ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER`,
+	`CREATE TABLE IF NOT EXISTS team_rotation_cursors (
+		team_id INTEGER PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+		last_user_id TEXT,
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`,
+	`CREATE TABLE IF NOT EXISTS user_unavailability (
+		id BIGSERIAL PRIMARY KEY,
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		starts_at TIMESTAMPTZ NOT NULL,
+		ends_at TIMESTAMPTZ NOT NULL,
+		reason TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		cancelled_at TIMESTAMPTZ,
+		settled_at TIMESTAMPTZ,
+		CHECK (ends_at > starts_at)
+	)`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS prefer_working_hours BOOLEAN`,
+	`ALTER TABLE users ADD COLUMN IF NOT EXISTS timezone TEXT`,
+	`ALTER TABLE users ADD COLUMN IF NOT EXISTS work_start TIME`,
+	`ALTER TABLE users ADD COLUMN IF NOT EXISTS work_end TIME`,
+	`CREATE TABLE IF NOT EXISTS team_fallbacks (
+		team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+		fallback_team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+		priority INTEGER NOT NULL,
+		PRIMARY KEY (team_id, fallback_team_id),
+		CHECK (team_id <> fallback_team_id)
+	)`,
+	`ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS fallback_team_id INTEGER REFERENCES teams(id) ON DELETE SET NULL`,
+	`CREATE TABLE IF NOT EXISTS assignment_events (
+		id BIGSERIAL PRIMARY KEY,
+		pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+		event_type TEXT NOT NULL CHECK (event_type IN ('ASSIGNED','REASSIGNED')),
+		reviewer_id TEXT NOT NULL,
+		previous_reviewer_id TEXT,
+		actor TEXT NOT NULL DEFAULT '',
+		reason TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`,
+	`ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS verdict TEXT CHECK (verdict IN ('APPROVED','CHANGES_REQUESTED','COMMENTED'))`,
+	`ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS verdict_at TIMESTAMPTZ`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS required_approvals INTEGER`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS block_on_changes_requested BOOLEAN`,
+	`CREATE TABLE IF NOT EXISTS merge_overrides (
+		id BIGSERIAL PRIMARY KEY,
+		pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+		violation TEXT NOT NULL,
+		actor TEXT NOT NULL DEFAULT '',
+		reason TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`,
+	`DO $$
+	BEGIN
+		IF NOT EXISTS (
+			SELECT 1
+			FROM pg_constraint
+			WHERE conname = 'pull_requests_status_check'
+			  AND pg_get_constraintdef(oid) LIKE '%CLOSED%'
+		) THEN
+			ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+			ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_status_check
+				CHECK (status IN ('DRAFT','OPEN','MERGED','CLOSED'));
+		END IF;
+	END $$`,
+	`ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS drafted_at TIMESTAMPTZ`,
+	`ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS opened_at TIMESTAMPTZ`,
+	`ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ`,
+	`UPDATE pull_requests SET opened_at = created_at WHERE opened_at IS NULL AND status IN ('OPEN','MERGED')`,
+	`ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS reviewers_count INTEGER`,
+	`ALTER TABLE users ALTER COLUMN team_id DROP NOT NULL`,
+	`CREATE TABLE IF NOT EXISTS team_membership_events (
+		id BIGSERIAL PRIMARY KEY,
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		from_team TEXT,
+		to_team TEXT,
+		actor TEXT NOT NULL DEFAULT '',
+		reason TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ`,
+	`DO $$
+	DECLARE
+		fk TEXT;
+	BEGIN
+		SELECT conname INTO fk
+		FROM pg_constraint
+		WHERE conrelid = 'users'::regclass
+		  AND confrelid = 'teams'::regclass
+		  AND contype = 'f'
+		  AND confdeltype = 'c';
+		IF fk IS NOT NULL THEN
+			EXECUTE format('ALTER TABLE users DROP CONSTRAINT %I', fk);
+			ALTER TABLE users ADD CONSTRAINT users_team_id_fkey
+				FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT;
+		END IF;
+	END $$`,
+	`CREATE TABLE IF NOT EXISTS team_aliases (
+		name TEXT PRIMARY KEY,
+		team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+		expires_at TIMESTAMPTZ NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`,
+	`ALTER TABLE teams ADD COLUMN IF NOT EXISTS parent_id INTEGER REFERENCES teams(id) ON DELETE RESTRICT`,
+	`ALTER TABLE users ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMPTZ`,
+	`DO $$
+	BEGIN
+		IF EXISTS (
+			SELECT 1
+			FROM pg_constraint
+			WHERE conname = 'assignment_events_event_type_check'
+			  AND pg_get_constraintdef(oid) LIKE '%UNASSIGNED%'
+		) THEN
+			ALTER TABLE assignment_events DROP CONSTRAINT assignment_events_event_type_check;
+			ALTER TABLE assignment_events ADD CONSTRAINT assignment_events_event_type_check
+				CHECK (event_type IN ('ASSIGNED','REASSIGNED'));
+		END IF;
+	END $$`,
 
 	`CREATE INDEX IF NOT EXISTS idx_users_team_active ON users(team_id, is_active)`,
 	`CREATE INDEX IF NOT EXISTS idx_pull_request_reviewers_reviewer ON pull_request_reviewers(reviewer_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_user_unavailability_user ON user_unavailability(user_id, ends_at)`,
+	`CREATE INDEX IF NOT EXISTS idx_assignment_events_pull_request ON assignment_events(pull_request_id, created_at)`,
+	`CREATE INDEX IF NOT EXISTS idx_pull_requests_created ON pull_requests(created_at, id)`,
+	`CREATE INDEX IF NOT EXISTS idx_team_membership_events_user ON team_membership_events(user_id, created_at)`,
+	`CREATE INDEX IF NOT EXISTS idx_team_aliases_team ON team_aliases(team_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_teams_parent ON teams(parent_id)`,
 }
 
 func EnsureSchema(ctx context.Context, pool *pgxpool.Pool) error {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	openapi "github.com/TheProgrammer256/PR-Reviewer-Assignment-Service/go"
 
@@ -13,28 +14,29 @@ import (
 
 type APIService struct {
 	repo *storage.Repository
+	// teamAliasGrace is how long a renamed team stays reachable by its
+	// former name.
+	teamAliasGrace time.Duration
 }
 
 var _ openapi.PullRequestsAPIServicer = (*APIService)(nil)
 var _ openapi.TeamsAPIServicer = (*APIService)(nil)
 var _ openapi.UsersAPIServicer = (*APIService)(nil)
 
-func New(repo *storage.Repository) *APIService {
-	return &APIService{repo: repo}
+func New(repo *storage.Repository, teamAliasGrace time.Duration) *APIService {
+	return &APIService{repo: repo, teamAliasGrace: teamAliasGrace}
 }
 
 // POST /team/add
-func (s *APIService) CreateTeam(ctx context.Context, team openapi.Team) (openapi.ImplResponse, error) {
-	members := make([]storage.TeamMember, 0, len(team.Members))
-	for _, member := range team.Members {
-		members = append(members, storage.TeamMember{
-			ID:       member.UserId,
-			Username: member.Username,
-			IsActive: member.IsActive,
-		})
+func (s *APIService) CreateTeam(ctx context.Context, team openapi.Team, allowMove bool, actor string) (openapi.ImplResponse, error) {
+	var parentName string
+	if team.ParentTeamName != nil {
+		parentName = *team.ParentTeamName
 	}
-
-	created, err := s.repo.CreateTeam(ctx, team.TeamName, members)
+	created, err := s.repo.CreateTeam(ctx, team.TeamName, parentName, settingsFromAPI(team.Settings), membersFromAPI(team.Members), allowMove, storage.Audit{
+		Actor:  actor,
+		Reason: "moved by team creation",
+	})
 	if err != nil {
 		return s.fail(err)
 	}
@@ -46,75 +48,473 @@ func (s *APIService) CreateTeam(ctx context.Context, team openapi.Team) (openapi
 }
 
 // GET /team/get
-func (s *APIService) GetTeam(ctx context.Context, teamName string) (openapi.ImplResponse, error) {
+func (s *APIService) GetTeam(ctx context.Context, teamName string, includeSubTeams bool) (openapi.ImplResponse, error) {
 	team, err := s.repo.GetTeam(ctx, teamName)
 	if err != nil {
 		return s.fail(err)
 	}
-	return openapi.Response(http.StatusOK, teamToAPI(team)), nil
+	resp := teamToAPI(team)
+	if includeSubTeams {
+		subTeams, err := s.repo.TeamTree(ctx, &team.ID)
+		if err != nil {
+			return s.fail(err)
+		}
+		resp.SubTeams = teamNodesToAPI(subTeams)
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /team/setSettings
+func (s *APIService) UpdateTeamSettings(ctx context.Context, req openapi.UpdateTeamSettingsRequest) (openapi.ImplResponse, error) {
+	team, err := s.repo.UpdateTeamSettings(ctx, req.TeamName, settingsFromAPI(req.Settings))
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.CreateTeam201Response{
+		Team: teamToAPI(team),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /team/addMembers
+func (s *APIService) AddTeamMembers(ctx context.Context, req openapi.AddTeamMembersRequest) (openapi.ImplResponse, error) {
+	team, err := s.repo.AddTeamMembers(ctx, req.TeamName, membersFromAPI(req.Members))
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.CreateTeam201Response{
+		Team: teamToAPI(team),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /team/removeMember
+func (s *APIService) RemoveTeamMember(ctx context.Context, req openapi.RemoveTeamMemberRequest) (openapi.ImplResponse, error) {
+	team, handover, err := s.repo.RemoveTeamMember(ctx, req.TeamName, req.UserId, storage.Audit{
+		Actor:  req.Actor,
+		Reason: "team member removed",
+	})
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.RemoveTeamMember200Response{
+		Team:                teamToAPI(team),
+		ReassignedReviews:   reassignmentsToAPI(handover.Reassigned),
+		UnreassignedReviews: handover.WithoutCandidate,
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /team/moveMember
+func (s *APIService) MoveTeamMember(ctx context.Context, req openapi.MoveTeamMemberRequest) (openapi.ImplResponse, error) {
+	user, handover, err := s.repo.MoveTeamMember(ctx, req.UserId, req.TeamName, storage.Audit{
+		Actor:  req.Actor,
+		Reason: "team member moved",
+	})
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.UpdateActiveFlag200Response{
+		User:                userToAPI(user),
+		ReassignedReviews:   reassignmentsToAPI(handover.Reassigned),
+		UnreassignedReviews: handover.WithoutCandidate,
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// PUT /team/sync
+func (s *APIService) SyncTeams(ctx context.Context, req openapi.SyncTeamsRequest, dryRun bool) (openapi.ImplResponse, error) {
+	rosters := make([]storage.Roster, 0, len(req.Teams))
+	for _, team := range req.Teams {
+		rosters = append(rosters, storage.Roster{
+			TeamName: team.TeamName,
+			Members:  membersFromAPI(team.Members),
+		})
+	}
+	result, err := s.repo.SyncTeams(ctx, rosters, dryRun)
+	if err != nil {
+		return s.fail(err)
+	}
+	changes := make([]openapi.TeamSyncChange, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		changes = append(changes, openapi.TeamSyncChange{
+			TeamName:     change.TeamName,
+			TeamId:       change.TeamID,
+			UserId:       change.UserID,
+			Action:       change.Action,
+			FromTeamName: change.FromTeam,
+		})
+	}
+	resp := openapi.SyncTeams200Response{
+		DryRun:              dryRun,
+		Changes:             changes,
+		ReassignedReviews:   reassignmentsToAPI(result.Handover.Reassigned),
+		UnreassignedReviews: result.Handover.WithoutCandidate,
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /team/archive
+func (s *APIService) ArchiveTeam(ctx context.Context, req openapi.ArchiveTeamRequest) (openapi.ImplResponse, error) {
+	team, err := s.repo.ArchiveTeam(ctx, req.TeamName)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.CreateTeam201Response{
+		Team: teamToAPI(team),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /team/delete
+func (s *APIService) DeleteTeam(ctx context.Context, req openapi.ArchiveTeamRequest) (openapi.ImplResponse, error) {
+	team, err := s.repo.DeleteTeam(ctx, req.TeamName)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.CreateTeam201Response{
+		Team: teamToAPI(team),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /team/rename
+func (s *APIService) RenameTeam(ctx context.Context, req openapi.RenameTeamRequest) (openapi.ImplResponse, error) {
+	team, err := s.repo.RenameTeam(ctx, req.TeamName, req.NewTeamName, s.teamAliasGrace)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.CreateTeam201Response{
+		Team: teamToAPI(team),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /team/setParent
+func (s *APIService) SetTeamParent(ctx context.Context, req openapi.SetTeamParentRequest) (openapi.ImplResponse, error) {
+	var parentName string
+	if req.ParentTeamName != nil {
+		parentName = *req.ParentTeamName
+	}
+	team, err := s.repo.SetTeamParent(ctx, req.TeamName, parentName)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.CreateTeam201Response{
+		Team: teamToAPI(team),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// GET /team/tree
+func (s *APIService) GetTeamTree(ctx context.Context) (openapi.ImplResponse, error) {
+	teams, err := s.repo.TeamTree(ctx, nil)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.GetTeamTree200Response{
+		Teams: teamNodesToAPI(teams),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
 }
 
-// POST /users/setIsActive
 func (s *APIService) UpdateActiveFlag(ctx context.Context, req openapi.UpdateActiveFlagRequest) (openapi.ImplResponse, error) {
-	user, err := s.repo.UpdateUserActive(ctx, req.UserId, req.IsActive)
+	user, handover, err := s.repo.UpdateUserActive(ctx, req.UserId, req.IsActive)
 	if err != nil {
 		return s.fail(err)
 	}
 	resp := openapi.UpdateActiveFlag200Response{
+		User:                userToAPI(user),
+		ReassignedReviews:   reassignmentsToAPI(handover.Reassigned),
+		UnreassignedReviews: handover.WithoutCandidate,
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /users/setMaxOpenReviews
+func (s *APIService) UpdateMaxOpenReviews(ctx context.Context, req openapi.UpdateMaxOpenReviewsRequest) (openapi.ImplResponse, error) {
+	user, err := s.repo.UpdateUserMaxOpenReviews(ctx, req.UserId, intPtr(req.MaxOpenReviews))
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.UpdateMaxOpenReviews200Response{
 		User: userToAPI(user),
 	}
 	return openapi.Response(http.StatusOK, resp), nil
 }
 
+// POST /users/setWorkingHours
+func (s *APIService) UpdateWorkingHours(ctx context.Context, req openapi.UpdateWorkingHoursRequest) (openapi.ImplResponse, error) {
+	var hours *storage.WorkingHours
+	if req.WorkingHours != nil {
+		hours = &storage.WorkingHours{
+			Timezone: req.WorkingHours.Timezone,
+			Start:    req.WorkingHours.Start,
+			End:      req.WorkingHours.End,
+		}
+	}
+	user, err := s.repo.UpdateUserWorkingHours(ctx, req.UserId, hours)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.UpdateMaxOpenReviews200Response{
+		User: userToAPI(user),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /users/addUnavailability
+func (s *APIService) AddUnavailability(ctx context.Context, req openapi.AddUnavailabilityRequest) (openapi.ImplResponse, error) {
+	period, err := s.repo.AddUnavailability(ctx, req.UserId, req.StartsAt, req.EndsAt, req.Reason)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.AddUnavailability201Response{
+		Unavailability: unavailabilityToAPI(period),
+	}
+	return openapi.Response(http.StatusCreated, resp), nil
+}
+
+// GET /users/getUnavailability
+func (s *APIService) GetUnavailability(ctx context.Context, userID string) (openapi.ImplResponse, error) {
+	periods, err := s.repo.ListUnavailability(ctx, userID)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.GetUnavailability200Response{
+		UserId:  userID,
+		Periods: make([]openapi.Unavailability, 0, len(periods)),
+	}
+	for _, period := range periods {
+		resp.Periods = append(resp.Periods, unavailabilityToAPI(period))
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /users/cancelUnavailability
+func (s *APIService) CancelUnavailability(ctx context.Context, req openapi.CancelUnavailabilityRequest) (openapi.ImplResponse, error) {
+	period, err := s.repo.CancelUnavailability(ctx, req.UnavailabilityId)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.AddUnavailability201Response{
+		Unavailability: unavailabilityToAPI(period),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
 // POST /pullRequest/create
 func (s *APIService) CreatePullRequestAndAssign(ctx context.Context, req openapi.CreatePullRequestAndAssignRequest) (openapi.ImplResponse, error) {
-	pr, err := s.repo.CreatePullRequest(ctx, req.PullRequestId, req.PullRequestName, req.AuthorId)
+	pr, summary, err := s.repo.CreatePullRequest(ctx, req.PullRequestId, req.PullRequestName, req.AuthorId, int(req.ReviewersCount), req.Draft)
 	if err != nil {
 		return s.fail(err)
 	}
 	resp := openapi.CreatePullRequestAndAssign201Response{
-		Pr: prToAPI(pr),
+		Pr:           prToAPI(pr),
+		Understaffed: summary.Understaffed,
+		MinReviewers: int32(summary.MinReviewers),
 	}
 	return openapi.Response(http.StatusCreated, resp), nil
 }
 
 // POST /pullRequest/merge
 func (s *APIService) UpdateMergedFlag(ctx context.Context, req openapi.UpdateMergedFlagRequest) (openapi.ImplResponse, error) {
-	pr, err := s.repo.UpdatePullRequestMerged(ctx, req.PullRequestId)
+	current, err := s.repo.GetPullRequest(ctx, req.PullRequestId)
 	if err != nil {
 		return s.fail(err)
 	}
-	resp := openapi.CreatePullRequestAndAssign201Response{
+	if current.Status != storage.StatusMerged {
+		if err := checkTransition(current.Status, storage.StatusMerged); err != nil {
+			return s.fail(err)
+		}
+	}
+	pr, err := s.repo.UpdatePullRequestMerged(ctx, req.PullRequestId, current.Status, req.Force, storage.Audit{
+		Actor:  req.Actor,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.UpdateMergedFlag200Response{
 		Pr: prToAPI(pr),
 	}
 	return openapi.Response(http.StatusOK, resp), nil
 }
 
+// POST /pullRequest/close
+func (s *APIService) ClosePullRequest(ctx context.Context, req openapi.ClosePullRequestRequest) (openapi.ImplResponse, error) {
+	pr, err := s.transitionPullRequest(ctx, req.PullRequestId, storage.StatusClosed)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.UpdateMergedFlag200Response{
+		Pr: prToAPI(pr),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /pullRequest/reopen
+func (s *APIService) ReopenPullRequest(ctx context.Context, req openapi.ClosePullRequestRequest) (openapi.ImplResponse, error) {
+	current, err := s.repo.GetPullRequest(ctx, req.PullRequestId)
+	if err != nil {
+		return s.fail(err)
+	}
+	to := reopenTarget(current)
+	if err := checkTransition(current.Status, to); err != nil {
+		return s.fail(err)
+	}
+	pr, err := s.repo.TransitionPullRequest(ctx, req.PullRequestId, current.Status, to)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.UpdateMergedFlag200Response{
+		Pr: prToAPI(pr),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /pullRequest/ready
+func (s *APIService) MarkPullRequestReady(ctx context.Context, req openapi.ClosePullRequestRequest) (openapi.ImplResponse, error) {
+	current, err := s.repo.GetPullRequest(ctx, req.PullRequestId)
+	if err != nil {
+		return s.fail(err)
+	}
+	// Only a draft is marked ready; CLOSED goes back through /pullRequest/reopen.
+	if current.Status != storage.StatusDraft {
+		return s.fail(&invalidTransitionError{from: current.Status, to: storage.StatusOpen})
+	}
+	pr, summary, err := s.repo.MarkPullRequestReady(ctx, req.PullRequestId)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.CreatePullRequestAndAssign201Response{
+		Pr:           prToAPI(pr),
+		Understaffed: summary.Understaffed,
+		MinReviewers: int32(summary.MinReviewers),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
 // POST /pullRequest/reassign
 func (s *APIService) ReassignUserOnPullRequest(ctx context.Context, req openapi.ReassignUserOnPullRequestRequest) (openapi.ImplResponse, error) {
-	pr, replacement, err := s.repo.ReassignReviewer(ctx, req.PullRequestId, req.OldUserId)
+	pr, reassignment, err := s.repo.ReassignReviewer(ctx, req.PullRequestId, req.OldUserId, req.NewUserId, storage.Audit{
+		Actor:  req.Actor,
+		Reason: req.Reason,
+	})
 	if err != nil {
 		return s.fail(err)
 	}
 	resp := openapi.ReassignUserOnPullRequest200Response{
-		Pr:         prToAPI(pr),
-		ReplacedBy: replacement,
+		Pr:               prToAPI(pr),
+		ReplacedBy:       reassignment.ReplacedBy,
+		ExclusionRelaxed: reassignment.ExclusionRelaxed,
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// POST /pullRequest/review
+func (s *APIService) SubmitReview(ctx context.Context, req openapi.SubmitReviewRequest) (openapi.ImplResponse, error) {
+	pr, err := s.repo.SubmitReview(ctx, req.PullRequestId, req.ReviewerId, req.Verdict)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.UpdateMergedFlag200Response{
+		Pr: prToAPI(pr),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// GET /pullRequest/get
+func (s *APIService) GetPullRequest(ctx context.Context, pullRequestID string) (openapi.ImplResponse, error) {
+	pr, err := s.repo.GetPullRequest(ctx, pullRequestID)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.UpdateMergedFlag200Response{
+		Pr: prToAPI(pr),
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// GET /pullRequest/list
+func (s *APIService) ListPullRequests(ctx context.Context, teamName, authorID, status string, createdFrom, createdTo time.Time, limit int32, cursor string) (openapi.ImplResponse, error) {
+	page, err := s.repo.ListPullRequests(ctx, storage.PullRequestFilter{
+		TeamName:    teamName,
+		AuthorID:    authorID,
+		Status:      status,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		Limit:       int(limit),
+		Cursor:      cursor,
+	})
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.ListPullRequests200Response{
+		PullRequests: make([]openapi.PullRequest, 0, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
+	}
+	for _, pr := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, prToAPI(pr))
+	}
+	return openapi.Response(http.StatusOK, resp), nil
+}
+
+// GET /pullRequest/history
+func (s *APIService) GetPullRequestHistory(ctx context.Context, pullRequestID string) (openapi.ImplResponse, error) {
+	events, err := s.repo.ListAssignmentEvents(ctx, pullRequestID)
+	if err != nil {
+		return s.fail(err)
+	}
+	resp := openapi.GetPullRequestHistory200Response{
+		PullRequestId: pullRequestID,
+		Events:        make([]openapi.AssignmentEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, openapi.AssignmentEvent{
+			EventId:            event.ID,
+			PullRequestId:      event.PullRequestID,
+			EventType:          event.Type,
+			ReviewerId:         event.ReviewerID,
+			PreviousReviewerId: event.PreviousReviewerID,
+			Actor:              event.Actor,
+			Reason:             event.Reason,
+			CreatedAt:          event.CreatedAt.UTC(),
+		})
 	}
 	return openapi.Response(http.StatusOK, resp), nil
 }
 
 // GET /users/getReview
-func (s *APIService) GetPullRequestsByUser(ctx context.Context, userID string) (openapi.ImplResponse, error) {
-	prs, err := s.repo.ListPullRequestsByReviewer(ctx, userID)
+func (s *APIService) GetPullRequestsByUser(ctx context.Context, userID string, pendingOnly bool, status string, since time.Time, limit int32, cursor string, full bool) (openapi.ImplResponse, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return s.fail(err)
+	}
+	page, err := s.repo.ListPullRequestsByReviewer(ctx, userID, storage.ReviewFilter{
+		PendingOnly:   pendingOnly,
+		Status:        status,
+		Since:         since,
+		Limit:         int(limit),
+		Cursor:        cursor,
+		WithReviewers: full,
+	})
 	if err != nil {
 		return s.fail(err)
 	}
 	resp := openapi.GetPullRequestsByUser200Response{
-		UserId:       userID,
-		PullRequests: make([]openapi.PullRequestShort, 0, len(prs)),
+		UserId:       user.ID,
+		TeamName:     user.TeamName,
+		TeamId:       user.TeamID,
+		IsActive:     user.IsActive,
+		PullRequests: make([]openapi.PullRequestShort, 0, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
 	}
-	for _, pr := range prs {
+	for _, pr := range page.PullRequests {
 		resp.PullRequests = append(resp.PullRequests, prShortToAPI(pr))
+		if full {
+			resp.PullRequestsFull = append(resp.PullRequestsFull, prToAPI(pr))
+		}
 	}
 	return openapi.Response(http.StatusOK, resp), nil
 }
@@ -134,16 +534,82 @@ func mapError(err error) *apperr.APIError {
 		return apperr.New(http.StatusNotFound, "NOT_FOUND", "team not found")
 	case errors.Is(err, storage.ErrUserNotFound):
 		return apperr.New(http.StatusNotFound, "NOT_FOUND", "user not found")
+	case errors.Is(err, storage.ErrUserNotInTeam):
+		return apperr.New(http.StatusNotFound, "NOT_FOUND", "user is not a member of the team")
+	case errors.Is(err, storage.ErrUserInOtherTeam):
+		return apperr.New(http.StatusConflict, "USER_IN_OTHER_TEAM", err.Error())
+	case errors.Is(err, storage.ErrAuthorWithoutTeam):
+		return apperr.New(http.StatusConflict, "NO_TEAM", "author does not belong to a team")
+	case errors.Is(err, storage.ErrInvalidRoster):
+		return apperr.New(http.StatusBadRequest, "INVALID_ROSTER", err.Error())
+	case errors.Is(err, storage.ErrTeamArchived):
+		return apperr.New(http.StatusConflict, "TEAM_ARCHIVED", "team is archived")
+	case errors.Is(err, storage.ErrTeamHasActivePullRequests):
+		return apperr.New(http.StatusConflict, "TEAM_HAS_ACTIVE_PRS", err.Error())
+	case errors.Is(err, storage.ErrTeamNotEmpty):
+		return apperr.New(http.StatusConflict, "TEAM_NOT_EMPTY", err.Error())
+	case errors.Is(err, storage.ErrInvalidTeamParent):
+		return apperr.New(http.StatusBadRequest, "INVALID_PARENT", "team cannot be nested under itself or one of its sub-teams")
+	case errors.Is(err, storage.ErrInvalidSubTeamSettings):
+		return apperr.New(http.StatusBadRequest, "INVALID_SETTINGS", err.Error())
 	case errors.Is(err, storage.ErrPullRequestExists):
 		return apperr.New(http.StatusConflict, "PR_EXISTS", "pull request already exists")
 	case errors.Is(err, storage.ErrPullRequestNotFound):
 		return apperr.New(http.StatusNotFound, "NOT_FOUND", "pull request not found")
 	case errors.Is(err, storage.ErrPullRequestMerged):
 		return apperr.New(http.StatusConflict, "PR_MERGED", "pull request already merged")
+	case errors.Is(err, storage.ErrPullRequestNotOpen):
+		return apperr.New(http.StatusConflict, "PR_NOT_OPEN", "pull request is not open")
+	case errors.Is(err, storage.ErrPullRequestStateChanged):
+		return apperr.New(http.StatusConflict, "INVALID_TRANSITION", "pull request status changed concurrently")
+	case errors.As(err, new(*invalidTransitionError)):
+		return apperr.New(http.StatusConflict, "INVALID_TRANSITION", err.Error())
 	case errors.Is(err, storage.ErrReviewerNotAssigned):
 		return apperr.New(http.StatusConflict, "NOT_ASSIGNED", "reviewer is not assigned to this pull request")
 	case errors.Is(err, storage.ErrNoReviewerCandidate):
 		return apperr.New(http.StatusConflict, "NO_CANDIDATE", "no active replacement candidate available")
+	case errors.Is(err, storage.ErrUnknownStrategy):
+		return apperr.New(http.StatusBadRequest, "INVALID_SETTINGS", "unknown assignment strategy")
+	case errors.Is(err, storage.ErrInvalidReviewerLimits):
+		return apperr.New(http.StatusBadRequest, "INVALID_SETTINGS", "reviewer limits must satisfy 0 <= min_reviewers <= max_reviewers <= 10 and max_reviewers >= 1")
+	case errors.Is(err, storage.ErrInvalidReviewersCount):
+		return apperr.New(http.StatusBadRequest, "INVALID_REVIEWERS_COUNT", "reviewers_count must be between min_reviewers and max_reviewers of the team and not below required_approvals")
+	case errors.Is(err, storage.ErrInvalidOverflowPolicy):
+		return apperr.New(http.StatusBadRequest, "INVALID_SETTINGS", "unknown capacity overflow policy")
+	case errors.Is(err, storage.ErrInvalidCapacity):
+		return apperr.New(http.StatusBadRequest, "INVALID_CAPACITY", "max_open_reviews must be positive")
+	case errors.Is(err, storage.ErrCapacityExceeded):
+		return apperr.New(http.StatusConflict, "CAPACITY_EXCEEDED", "all reviewer candidates are at capacity")
+	case errors.Is(err, storage.ErrInvalidPeriod):
+		return apperr.New(http.StatusBadRequest, "INVALID_PERIOD", "ends_at must be after starts_at")
+	case errors.Is(err, storage.ErrInvalidDateRange):
+		return apperr.New(http.StatusBadRequest, "INVALID_PERIOD", "created_from must be before created_to")
+	case errors.Is(err, storage.ErrInvalidStatusFilter):
+		return apperr.New(http.StatusBadRequest, "INVALID_FILTER", "status must be DRAFT, OPEN, MERGED or CLOSED")
+	case errors.Is(err, storage.ErrInvalidCursor):
+		return apperr.New(http.StatusBadRequest, "INVALID_CURSOR", "invalid pagination cursor")
+	case errors.Is(err, storage.ErrUnavailabilityNotFound):
+		return apperr.New(http.StatusNotFound, "NOT_FOUND", "unavailability period not found")
+	case errors.Is(err, storage.ErrReviewerInactive):
+		return apperr.New(http.StatusConflict, "REVIEWER_INACTIVE", "new reviewer is not active")
+	case errors.Is(err, storage.ErrReviewerNotInTeam):
+		return apperr.New(http.StatusConflict, "REVIEWER_NOT_IN_TEAM", "new reviewer is not a member of the pull request team")
+	case errors.Is(err, storage.ErrReviewerIsAuthor):
+		return apperr.New(http.StatusConflict, "REVIEWER_IS_AUTHOR", "author cannot review own pull request")
+	case errors.Is(err, storage.ErrReviewerAlreadyAssigned):
+		return apperr.New(http.StatusConflict, "ALREADY_ASSIGNED", "new reviewer is already assigned to this pull request")
+	case errors.Is(err, storage.ErrInvalidVerdict):
+		return apperr.New(http.StatusBadRequest, "INVALID_VERDICT", "verdict must be APPROVED, CHANGES_REQUESTED or COMMENTED")
+	case errors.Is(err, storage.ErrInvalidMergePolicy):
+		return apperr.New(http.StatusBadRequest, "INVALID_SETTINGS", "required_approvals must be between 0 and max_reviewers")
+	case errors.Is(err, storage.ErrPolicyViolation):
+		return apperr.New(http.StatusConflict, "POLICY_VIOLATION", err.Error())
+	case errors.Is(err, storage.ErrForceReasonRequired):
+		return apperr.New(http.StatusBadRequest, "FORCE_REASON_REQUIRED", "forced merge requires a reason")
+	case errors.Is(err, storage.ErrInvalidFallbackTeams):
+		return apperr.New(http.StatusBadRequest, "INVALID_SETTINGS", "fallback_teams must list existing teams other than the team itself, each once")
+	case errors.Is(err, storage.ErrInvalidWorkingHours):
+		return apperr.New(http.StatusBadRequest, "INVALID_WORKING_HOURS", "working hours need a known IANA time zone and distinct HH:MM start and end")
 	default:
 		return nil
 	}
@@ -152,25 +618,146 @@ func mapError(err error) *apperr.APIError {
 func teamToAPI(team storage.Team) openapi.Team {
 	resp := openapi.Team{
 		TeamName: team.Name,
+		TeamId:   team.ID,
 		Members:  make([]openapi.TeamMember, 0, len(team.Members)),
+		Settings: settingsToAPI(team.Settings),
 	}
 	for _, member := range team.Members {
 		resp.Members = append(resp.Members, openapi.TeamMember{
-			UserId:   member.ID,
-			Username: member.Username,
-			IsActive: member.IsActive,
+			UserId:         member.ID,
+			Username:       member.Username,
+			IsActive:       member.IsActive,
+			MaxOpenReviews: int32Ptr(member.MaxOpenReviews),
 		})
 	}
+	if team.ArchivedAt != nil {
+		archived := team.ArchivedAt.UTC()
+		resp.ArchivedAt = &archived
+	}
+	if team.ParentID != nil {
+		parentID := *team.ParentID
+		parentName := team.ParentName
+		resp.ParentTeamId = &parentID
+		resp.ParentTeamName = &parentName
+	}
 	return resp
 }
 
+func teamNodesToAPI(nodes []storage.TeamNode) []openapi.TeamTreeNode {
+	out := make([]openapi.TeamTreeNode, 0, len(nodes))
+	for _, node := range nodes {
+		item := openapi.TeamTreeNode{
+			TeamId:   node.ID,
+			TeamName: node.Name,
+			SubTeams: teamNodesToAPI(node.SubTeams),
+		}
+		if node.ArchivedAt != nil {
+			archived := node.ArchivedAt.UTC()
+			item.ArchivedAt = &archived
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+func settingsToAPI(settings storage.TeamSettings) openapi.TeamSettings {
+	strategy := settings.AssignmentStrategy
+	minReviewers := int32(settings.MinReviewers)
+	maxReviewers := int32(settings.MaxReviewers)
+	maxOpenReviews := int32(settings.MaxOpenReviews)
+	overflow := settings.CapacityOverflow
+	preferWorkingHours := settings.PreferWorkingHours
+	requiredApprovals := int32(settings.RequiredApprovals)
+	blockOnChangesRequested := settings.BlockOnChangesRequested
+	return openapi.TeamSettings{
+		AssignmentStrategy:      &strategy,
+		MinReviewers:            &minReviewers,
+		MaxReviewers:            &maxReviewers,
+		MaxOpenReviews:          &maxOpenReviews,
+		CapacityOverflow:        &overflow,
+		PreferWorkingHours:      &preferWorkingHours,
+		FallbackTeams:           append([]string(nil), settings.FallbackTeams...),
+		RequiredApprovals:       &requiredApprovals,
+		BlockOnChangesRequested: &blockOnChangesRequested,
+	}
+}
+
+func settingsFromAPI(settings openapi.TeamSettings) storage.TeamSettingsPatch {
+	patch := storage.TeamSettingsPatch{
+		AssignmentStrategy:      settings.AssignmentStrategy,
+		MinReviewers:            intPtr(settings.MinReviewers),
+		MaxReviewers:            intPtr(settings.MaxReviewers),
+		MaxOpenReviews:          intPtr(settings.MaxOpenReviews),
+		CapacityOverflow:        settings.CapacityOverflow,
+		PreferWorkingHours:      settings.PreferWorkingHours,
+		RequiredApprovals:       intPtr(settings.RequiredApprovals),
+		BlockOnChangesRequested: settings.BlockOnChangesRequested,
+	}
+	if settings.FallbackTeams != nil {
+		fallbackTeams := append([]string{}, settings.FallbackTeams...)
+		patch.FallbackTeams = &fallbackTeams
+	}
+	return patch
+}
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func int32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
+
 func userToAPI(user storage.User) openapi.User {
-	return openapi.User{
-		UserId:   user.ID,
-		Username: user.Name,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+	apiUser := openapi.User{
+		UserId:         user.ID,
+		Username:       user.Name,
+		TeamName:       user.TeamName,
+		TeamId:         user.TeamID,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: int32Ptr(user.MaxOpenReviews),
+	}
+	if user.WorkingHours != nil {
+		apiUser.WorkingHours = &openapi.WorkingHours{
+			Timezone: user.WorkingHours.Timezone,
+			Start:    user.WorkingHours.Start,
+			End:      user.WorkingHours.End,
+		}
+	}
+	return apiUser
+}
+
+func membersFromAPI(members []openapi.TeamMember) []storage.TeamMember {
+	out := make([]storage.TeamMember, 0, len(members))
+	for _, member := range members {
+		out = append(out, storage.TeamMember{
+			ID:             member.UserId,
+			Username:       member.Username,
+			IsActive:       member.IsActive,
+			MaxOpenReviews: intPtr(member.MaxOpenReviews),
+		})
+	}
+	return out
+}
+
+func reassignmentsToAPI(reassignments []storage.Reassignment) []openapi.ReviewReassignment {
+	var out []openapi.ReviewReassignment
+	for _, reassignment := range reassignments {
+		out = append(out, openapi.ReviewReassignment{
+			PullRequestId:    reassignment.PullRequestID,
+			ReplacedBy:       reassignment.ReplacedBy,
+			ExclusionRelaxed: reassignment.ExclusionRelaxed,
+		})
 	}
+	return out
 }
 
 func prToAPI(pr storage.PullRequest) openapi.PullRequest {
@@ -185,18 +772,69 @@ func prToAPI(pr storage.PullRequest) openapi.PullRequest {
 		created := pr.CreatedAt.UTC()
 		apiPR.CreatedAt = &created
 	}
+	if pr.DraftedAt != nil {
+		drafted := pr.DraftedAt.UTC()
+		apiPR.DraftedAt = &drafted
+	}
+	if pr.OpenedAt != nil {
+		opened := pr.OpenedAt.UTC()
+		apiPR.OpenedAt = &opened
+	}
 	if pr.MergedAt != nil {
 		merged := pr.MergedAt.UTC()
 		apiPR.MergedAt = &merged
 	}
+	if pr.ClosedAt != nil {
+		closed := pr.ClosedAt.UTC()
+		apiPR.ClosedAt = &closed
+	}
+	for _, review := range pr.Reviews {
+		apiReview := openapi.ReviewVerdict{
+			ReviewerId: review.ReviewerID,
+			Verdict:    review.Verdict,
+		}
+		if review.SubmittedAt != nil {
+			submitted := review.SubmittedAt.UTC()
+			apiReview.SubmittedAt = &submitted
+		}
+		apiPR.Reviews = append(apiPR.Reviews, apiReview)
+	}
+	for _, reviewer := range pr.FallbackReviewers {
+		apiPR.FallbackReviewers = append(apiPR.FallbackReviewers, openapi.FallbackReviewer{
+			UserId:   reviewer.UserID,
+			TeamName: reviewer.TeamName,
+			TeamId:   reviewer.TeamID,
+		})
+	}
 	return apiPR
 }
 
-func prShortToAPI(pr storage.PullRequestShort) openapi.PullRequestShort {
-	return openapi.PullRequestShort{
+func prShortToAPI(pr storage.PullRequest) openapi.PullRequestShort {
+	apiPR := openapi.PullRequestShort{
 		PullRequestId:   pr.ID,
 		PullRequestName: pr.Name,
 		AuthorId:        pr.AuthorID,
 		Status:          pr.Status,
 	}
+	if !pr.CreatedAt.IsZero() {
+		created := pr.CreatedAt.UTC()
+		apiPR.CreatedAt = &created
+	}
+	return apiPR
+}
+
+func unavailabilityToAPI(period storage.Unavailability) openapi.Unavailability {
+	apiPeriod := openapi.Unavailability{
+		UnavailabilityId: period.ID,
+		UserId:           period.UserID,
+		StartsAt:         period.StartsAt.UTC(),
+		EndsAt:           period.EndsAt.UTC(),
+		Reason:           period.Reason,
+		CreatedAt:        period.CreatedAt.UTC(),
+	}
+	if period.CancelledAt != nil {
+		cancelled := period.CancelledAt.UTC()
+		apiPeriod.CancelledAt = &cancelled
+	}
+	return apiPeriod
 }
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/avito/pr-reviewer-assignment-service/internal/storage"
+)
+
+// pullRequestTransitions lists, for each pull request status, the statuses
+// it may move to. MERGED is terminal.
+var pullRequestTransitions = map[string][]string{
+	storage.StatusDraft:  {storage.StatusOpen, storage.StatusClosed},
+	storage.StatusOpen:   {storage.StatusMerged, storage.StatusClosed},
+	storage.StatusClosed: {storage.StatusDraft, storage.StatusOpen},
+}
+
+type invalidTransitionError struct {
+	from, to string
+}
+
+func (e *invalidTransitionError) Error() string {
+	return fmt.Sprintf("cannot move pull request from %s to %s", e.from, e.to)
+}
+
+func checkTransition(from, to string) error {
+	if !slices.Contains(pullRequestTransitions[from], to) {
+		return &invalidTransitionError{from: from, to: to}
+	}
+	return nil
+}
+
+// transitionPullRequest moves the pull request to the given status if the
+// state machine allows it from the status it is currently in.
+func (s *APIService) transitionPullRequest(ctx context.Context, id, to string) (storage.PullRequest, error) {
+	pr, err := s.repo.GetPullRequest(ctx, id)
+	if err != nil {
+		return storage.PullRequest{}, err
+	}
+	if err := checkTransition(pr.Status, to); err != nil {
+		return storage.PullRequest{}, err
+	}
+	return s.repo.TransitionPullRequest(ctx, id, pr.Status, to)
+}
+
+// reopenTarget returns the status a closed pull request goes back to: a pull
+// request closed while still a draft returns to DRAFT.
+func reopenTarget(pr storage.PullRequest) string {
+	if pr.OpenedAt == nil {
+		return storage.StatusDraft
+	}
+	return storage.StatusOpen
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/avito/pr-reviewer-assignment-service/internal/storage"
+)
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		allowed  bool
+	}{
+		{"draft is marked ready", storage.StatusDraft, storage.StatusOpen, true},
+		{"draft is closed", storage.StatusDraft, storage.StatusClosed, true},
+		{"draft cannot be merged", storage.StatusDraft, storage.StatusMerged, false},
+		{"open is merged", storage.StatusOpen, storage.StatusMerged, true},
+		{"open is closed", storage.StatusOpen, storage.StatusClosed, true},
+		{"open cannot go back to draft", storage.StatusOpen, storage.StatusDraft, false},
+		{"closed is reopened as draft", storage.StatusClosed, storage.StatusDraft, true},
+		{"closed is reopened", storage.StatusClosed, storage.StatusOpen, true},
+		{"closed cannot be merged", storage.StatusClosed, storage.StatusMerged, false},
+		{"merged cannot be reopened", storage.StatusMerged, storage.StatusOpen, false},
+		{"merged cannot go back to draft", storage.StatusMerged, storage.StatusDraft, false},
+		{"merged cannot be closed", storage.StatusMerged, storage.StatusClosed, false},
+		{"unknown status", "UNKNOWN", storage.StatusOpen, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTransition(tt.from, tt.to)
+			if (err == nil) != tt.allowed {
+				t.Errorf("checkTransition(%s, %s) = %v, want allowed %v", tt.from, tt.to, err, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestReopenTarget(t *testing.T) {
+	openedAt := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		pr   storage.PullRequest
+		want string
+	}{
+		{"closed while a draft", storage.PullRequest{Status: storage.StatusClosed}, storage.StatusDraft},
+		{"closed after being opened", storage.PullRequest{Status: storage.StatusClosed, OpenedAt: &openedAt}, storage.StatusOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reopenTarget(tt.pr)
+			if got != tt.want {
+				t.Errorf("reopenTarget() = %s, want %s", got, tt.want)
+			}
+			if err := checkTransition(tt.pr.Status, got); err != nil {
+				t.Errorf("checkTransition(%s, %s) = %v, want nil", tt.pr.Status, got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/avito/pr-reviewer-assignment-service/internal/assignment"
+)
+
+// candidate is an assignment candidate together with its review capacity and
+// schedule.
+type candidate struct {
+	assignment.Candidate
+	// Capacity is the maximum number of OPEN reviews; nil means unlimited.
+	Capacity     *int
+	WorkingHours *WorkingHours
+}
+
+func (c candidate) atCapacity() bool {
+	return c.Capacity != nil && c.OpenReviews >= *c.Capacity
+}
+
+func (c candidate) onDuty(at time.Time) bool {
+	return c.WorkingHours == nil || c.WorkingHours.Contains(at)
+}
+
+// pick is a reviewer chosen for a pull request. FallbackTeamID is set when
+// the reviewer was drawn from another team: one of the team's fallback teams
+// or one of its ancestors in the team tree.
+type pick struct {
+	UserID         string
+	FallbackTeamID *int64
+}
+
+// selectReviewers picks up to count reviewers for the pull request among the
+// active members of its team, using the team's assignment strategy. Slots the
+// team cannot fill with members below their capacity go to the fallback teams
+// and then up the team tree next; members at their capacity are only
+// considered after that, according to the team's overflow policy.
+func (r *Repository) selectReviewers(ctx context.Context, tx pgx.Tx, pr PullRequest, settings TeamSettings, exclude []string, count int) ([]pick, error) {
+	candidates, err := loadCandidates(ctx, tx, pr.TeamID, exclude, settings.MaxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	var available, full []candidate
+	for _, c := range candidates {
+		if c.atCapacity() {
+			full = append(full, c)
+		} else {
+			available = append(available, c)
+		}
+	}
+
+	reviewers, err := r.pickReviewers(ctx, tx, pr, pr.TeamID, settings, available, count)
+	if err != nil {
+		return nil, err
+	}
+	picks := make([]pick, 0, count)
+	for _, id := range reviewers {
+		picks = append(picks, pick{UserID: id})
+	}
+
+	if missing := count - len(picks); missing > 0 {
+		taken := append(append([]string(nil), exclude...), reviewers...)
+		extra, err := r.selectFromFallbacks(ctx, tx, pr, taken, missing)
+		if err != nil {
+			return nil, err
+		}
+		picks = append(picks, extra...)
+	}
+
+	missing := count - len(picks)
+	if missing == 0 || len(full) == 0 {
+		return picks, nil
+	}
+
+	switch settings.CapacityOverflow {
+	case OverflowAssign:
+		extra, err := r.pickReviewers(ctx, tx, pr, pr.TeamID, settings, full, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range extra {
+			picks = append(picks, pick{UserID: id})
+		}
+		return picks, nil
+	case OverflowReject:
+		return nil, ErrCapacityExceeded
+	default:
+		return picks, nil
+	}
+}
+
+// pickReviewers runs the team's strategy over the candidates. When the team
+// prefers working hours, members on duty right now are tried first and the rest
+// only fill the remaining slots.
+func (r *Repository) pickReviewers(ctx context.Context, tx pgx.Tx, pr PullRequest, teamID int64, settings TeamSettings, candidates []candidate, count int) ([]string, error) {
+	if !settings.PreferWorkingHours {
+		return r.runStrategy(ctx, tx, pr, teamID, settings, strategyCandidates(candidates), count)
+	}
+
+	now := time.Now()
+	var onDuty, offDuty []candidate
+	for _, c := range candidates {
+		if c.onDuty(now) {
+			onDuty = append(onDuty, c)
+		} else {
+			offDuty = append(offDuty, c)
+		}
+	}
+
+	reviewers, err := r.runStrategy(ctx, tx, pr, teamID, settings, strategyCandidates(onDuty), count)
+	if err != nil {
+		return nil, err
+	}
+	if missing := count - len(reviewers); missing > 0 && len(offDuty) > 0 {
+		extra, err := r.runStrategy(ctx, tx, pr, teamID, settings, strategyCandidates(offDuty), missing)
+		if err != nil {
+			return nil, err
+		}
+		reviewers = append(reviewers, extra...)
+	}
+	return reviewers, nil
+}
+
+func strategyCandidates(candidates []candidate) []assignment.Candidate {
+	out := make([]assignment.Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, c.Candidate)
+	}
+	return out
+}
+
+// runStrategy applies the team's assignment strategy to the candidates,
+// advancing the rotation cursor for round-robin teams.
+func (r *Repository) runStrategy(ctx context.Context, tx pgx.Tx, pr PullRequest, teamID int64, settings TeamSettings, candidates []assignment.Candidate, count int) ([]string, error) {
+	strategy := r.strategies.Get(settings.AssignmentStrategy)
+	req := assignment.Request{
+		PullRequestID: pr.ID,
+		AuthorID:      pr.AuthorID,
+		Candidates:    candidates,
+		Count:         count,
+	}
+	if strategy.Name() != assignment.StrategyRoundRobin || len(candidates) == 0 {
+		return strategy.Select(req), nil
+	}
+
+	var err error
+	if req.Cursor, err = lockRotationCursor(ctx, tx, teamID); err != nil {
+		return nil, err
+	}
+	reviewers := strategy.Select(req)
+	if len(reviewers) > 0 {
+		if _, err := tx.Exec(ctx, `
+			UPDATE team_rotation_cursors
+			SET last_user_id = $2,
+			    updated_at = NOW()
+			WHERE team_id = $1`,
+			teamID, reviewers[len(reviewers)-1],
+		); err != nil {
+			return nil, err
+		}
+	}
+	return reviewers, nil
+}
+
+// loadCandidates returns the active members of the team that are not inside an
+// unavailability period, except the given users, together with the number of
+// OPEN pull requests each one reviews, their capacity and working hours.
+// teamCapacity applies to members without a personal limit; zero means
+// unlimited.
+func loadCandidates(ctx context.Context, tx pgx.Tx, teamID int64, exclude []string, teamCapacity int) ([]candidate, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT u.id, COALESCE(load.open_reviews, 0), COALESCE(u.max_open_reviews, NULLIF($3::int, 0)),
+		       u.timezone, to_char(u.work_start, 'HH24:MI'), to_char(u.work_end, 'HH24:MI')
+		FROM users u
+		LEFT JOIN (
+			SELECT rvr.reviewer_id, COUNT(*) AS open_reviews
+			FROM pull_request_reviewers rvr
+			JOIN pull_requests pr ON pr.id = rvr.pull_request_id
+			WHERE pr.status = 'OPEN'
+			GROUP BY rvr.reviewer_id
+		) load ON load.reviewer_id = u.id
+		WHERE u.team_id = $1
+		  AND u.is_active
+		  AND u.id <> ALL($2)
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM user_unavailability ua
+			WHERE ua.user_id = u.id
+			  AND ua.cancelled_at IS NULL
+			  AND NOW() >= ua.starts_at
+			  AND NOW() < ua.ends_at
+		  )
+		ORDER BY u.id`,
+		teamID, exclude, teamCapacity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []candidate
+	for rows.Next() {
+		var (
+			c                    candidate
+			timezone, start, end *string
+		)
+		if err := rows.Scan(&c.UserID, &c.OpenReviews, &c.Capacity, &timezone, &start, &end); err != nil {
+			return nil, err
+		}
+		c.WorkingHours = workingHours(timezone, start, end)
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+// lockRotationCursor returns the last user handed a review by the team's
+// rotation and locks the cursor row until the transaction ends, so concurrent
+// assignments in the same team never reuse a slot.
+func lockRotationCursor(ctx context.Context, tx pgx.Tx, teamID int64) (string, error) {
+	var lastUserID *string
+	err := tx.QueryRow(ctx, `
+		INSERT INTO team_rotation_cursors (team_id)
+		VALUES ($1)
+		ON CONFLICT (team_id) DO UPDATE
+		SET team_id = EXCLUDED.team_id
+		RETURNING last_user_id`,
+		teamID,
+	).Scan(&lastUserID)
+	if err != nil || lastUserID == nil {
+		return "", err
+	}
+	return *lastUserID, nil
+}
+
+// lookupTeamID resolves a team by its current name or by a former name whose
+// alias has not expired yet. Every API that addresses a team by name goes
+// through it.
+func lookupTeamID(ctx context.Context, q querier, name string) (int64, error) {
+	var teamID *int64
+	if err := q.QueryRow(ctx, `
+		SELECT COALESCE(
+			(SELECT id FROM teams WHERE name = $1),
+			(SELECT team_id FROM team_aliases WHERE name = $1 AND expires_at > NOW())
+		)`,
+		name,
+	).Scan(&teamID); err != nil {
+		return 0, err
+	}
+	if teamID == nil {
+		return 0, ErrTeamNotFound
+	}
+	return *teamID, nil
+}
@@ -0,0 +1,150 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// deactivationLeadTime is how long before an unavailability period a user may
+// deactivate themselves, e.g. on the evening before a vacation, and still be
+// turned back on when the period ends.
+const deactivationLeadTime = 24 * time.Hour
+
+const unavailabilityColumns = `id, user_id, starts_at, ends_at, reason, created_at, cancelled_at`
+
+func scanUnavailability(row pgx.Row) (Unavailability, error) {
+	var u Unavailability
+	err := row.Scan(&u.ID, &u.UserID, &u.StartsAt, &u.EndsAt, &u.Reason, &u.CreatedAt, &u.CancelledAt)
+	return u, err
+}
+
+func (r *Repository) AddUnavailability(ctx context.Context, userID string, startsAt, endsAt time.Time, reason string) (Unavailability, error) {
+	if !endsAt.After(startsAt) {
+		return Unavailability{}, ErrInvalidPeriod
+	}
+	if err := ensureUserExists(ctx, r.pool, userID); err != nil {
+		return Unavailability{}, err
+	}
+
+	return scanUnavailability(r.pool.QueryRow(ctx, `
+		INSERT INTO user_unavailability (user_id, starts_at, ends_at, reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+unavailabilityColumns,
+		userID, startsAt, endsAt, reason,
+	))
+}
+
+func (r *Repository) ListUnavailability(ctx context.Context, userID string) ([]Unavailability, error) {
+	if err := ensureUserExists(ctx, r.pool, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+unavailabilityColumns+`
+		FROM user_unavailability
+		WHERE user_id = $1
+		ORDER BY starts_at, id`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []Unavailability
+	for rows.Next() {
+		period, err := scanUnavailability(rows)
+		if err != nil {
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+	return periods, rows.Err()
+}
+
+// CancelUnavailability marks the period as cancelled. Cancelling an already
+// cancelled period is a no-op.
+func (r *Repository) CancelUnavailability(ctx context.Context, id int64) (Unavailability, error) {
+	period, err := scanUnavailability(r.pool.QueryRow(ctx, `
+		UPDATE user_unavailability
+		SET cancelled_at = COALESCE(cancelled_at, NOW())
+		WHERE id = $1
+		RETURNING `+unavailabilityColumns,
+		id,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Unavailability{}, ErrUnavailabilityNotFound
+	}
+	return period, err
+}
+
+// ReactivateAfterUnavailability turns users back on whose unavailability period
+// has ended while they were still deactivated, provided they were deactivated
+// through UpdateUserActive during the period or at most deactivationLeadTime
+// before it started. Users deactivated earlier, or deactivated or moved by
+// team membership changes, stay inactive. Each ended period is processed once.
+// It returns the ids of reactivated users.
+func (r *Repository) ReactivateAfterUnavailability(ctx context.Context) ([]string, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		WITH ended AS (
+			UPDATE user_unavailability
+			SET settled_at = NOW()
+			WHERE cancelled_at IS NULL
+			  AND settled_at IS NULL
+			  AND ends_at <= NOW()
+			RETURNING user_id, starts_at, ends_at
+		)
+		UPDATE users u
+		SET is_active = TRUE,
+		    deactivated_at = NULL,
+		    updated_at = NOW()
+		FROM ended
+		WHERE u.id = ended.user_id
+		  AND NOT u.is_active
+		  AND u.deactivated_at >= ended.starts_at - make_interval(secs => $1)
+		  AND u.deactivated_at <= ended.ends_at
+		RETURNING u.id`,
+		deactivationLeadTime.Seconds(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+func ensureUserExists(ctx context.Context, q querier, userID string) error {
+	var exists bool
+	if err := q.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)`, userID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return ErrUserNotFound
+	}
+	return nil
+}
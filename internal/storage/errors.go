@@ -3,12 +3,44 @@ package storage
 import "errors"
 
 var (
-	ErrTeamExists          = errors.New("team already exists")
-	ErrTeamNotFound        = errors.New("team not found")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrPullRequestExists   = errors.New("pull request already exists")
-	ErrPullRequestNotFound = errors.New("pull request not found")
-	ErrPullRequestMerged   = errors.New("pull request already merged")
-	ErrReviewerNotAssigned = errors.New("reviewer not assigned to pull request")
-	ErrNoReviewerCandidate = errors.New("no active reviewer candidates available")
+	ErrTeamExists                = errors.New("team already exists")
+	ErrTeamNotFound              = errors.New("team not found")
+	ErrUserNotFound              = errors.New("user not found")
+	ErrPullRequestExists         = errors.New("pull request already exists")
+	ErrPullRequestNotFound       = errors.New("pull request not found")
+	ErrPullRequestMerged         = errors.New("pull request already merged")
+	ErrReviewerNotAssigned       = errors.New("reviewer not assigned to pull request")
+	ErrNoReviewerCandidate       = errors.New("no active reviewer candidates available")
+	ErrUnknownStrategy           = errors.New("unknown assignment strategy")
+	ErrInvalidReviewerLimits     = errors.New("invalid reviewer limits")
+	ErrInvalidReviewersCount     = errors.New("reviewers count outside team limits")
+	ErrInvalidCapacity           = errors.New("invalid review capacity")
+	ErrInvalidOverflowPolicy     = errors.New("unknown capacity overflow policy")
+	ErrCapacityExceeded          = errors.New("all reviewer candidates are at capacity")
+	ErrInvalidPeriod             = errors.New("period must end after it starts")
+	ErrUnavailabilityNotFound    = errors.New("unavailability period not found")
+	ErrInvalidWorkingHours       = errors.New("invalid working hours")
+	ErrInvalidFallbackTeams      = errors.New("invalid fallback teams")
+	ErrReviewerInactive          = errors.New("reviewer is not active")
+	ErrReviewerNotInTeam         = errors.New("reviewer is not in the pull request team")
+	ErrReviewerIsAuthor          = errors.New("reviewer is the pull request author")
+	ErrReviewerAlreadyAssigned   = errors.New("reviewer already assigned")
+	ErrInvalidVerdict            = errors.New("unknown review verdict")
+	ErrInvalidMergePolicy        = errors.New("invalid merge policy")
+	ErrPolicyViolation           = errors.New("merge policy violated")
+	ErrForceReasonRequired       = errors.New("forced merge requires a reason")
+	ErrPullRequestNotOpen        = errors.New("pull request is not open")
+	ErrPullRequestStateChanged   = errors.New("pull request status changed concurrently")
+	ErrInvalidStatusFilter       = errors.New("unknown pull request status")
+	ErrInvalidCursor             = errors.New("invalid pagination cursor")
+	ErrInvalidDateRange          = errors.New("invalid date range")
+	ErrUserInOtherTeam           = errors.New("user belongs to another team")
+	ErrUserNotInTeam             = errors.New("user is not a member of the team")
+	ErrAuthorWithoutTeam         = errors.New("author does not belong to a team")
+	ErrInvalidRoster             = errors.New("invalid team roster")
+	ErrTeamArchived              = errors.New("team is archived")
+	ErrTeamHasActivePullRequests = errors.New("team has active pull requests")
+	ErrTeamNotEmpty              = errors.New("team is not empty")
+	ErrInvalidTeamParent         = errors.New("invalid team parent")
+	ErrInvalidSubTeamSettings    = errors.New("invalid settings of sub-team")
 )
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Assignment event types.
+const (
+	EventAssigned   = "ASSIGNED"
+	EventReassigned = "REASSIGNED"
+)
+
+// ActorSystem identifies changes the service makes on its own, such as the
+// initial assignment or a handover after deactivation.
+const ActorSystem = "system"
+
+// recordEvent appends an event to the assignment history. It must run in the
+// transaction that makes the change it describes.
+func recordEvent(ctx context.Context, q querier, event AssignmentEvent) error {
+	_, err := q.Exec(ctx, `
+		INSERT INTO assignment_events (pull_request_id, event_type, reviewer_id, previous_reviewer_id, actor, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		event.PullRequestID, event.Type, event.ReviewerID, event.PreviousReviewerID, event.Actor, event.Reason,
+	)
+	return err
+}
+
+// removedReviewers returns the users that were ever replaced on the pull
+// request.
+func removedReviewers(ctx context.Context, q querier, pullRequestID string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+		SELECT DISTINCT previous_reviewer_id
+		FROM assignment_events
+		WHERE pull_request_id = $1
+		  AND event_type = 'REASSIGNED'
+		  AND previous_reviewer_id IS NOT NULL`,
+		pullRequestID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ListAssignmentEvents returns the assignment history of the pull request,
+// oldest first.
+func (r *Repository) ListAssignmentEvents(ctx context.Context, pullRequestID string) ([]AssignmentEvent, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `SELECT TRUE FROM pull_requests WHERE id = $1`, pullRequestID).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPullRequestNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, pull_request_id, event_type, reviewer_id, previous_reviewer_id, actor, reason, created_at
+		FROM assignment_events
+		WHERE pull_request_id = $1
+		ORDER BY created_at, id`,
+		pullRequestID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []AssignmentEvent{}
+	for rows.Next() {
+		var e AssignmentEvent
+		if err := rows.Scan(&e.ID, &e.PullRequestID, &e.Type, &e.ReviewerID, &e.PreviousReviewerID, &e.Actor, &e.Reason, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
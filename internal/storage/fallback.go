@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// loadFallbackTeams returns the names of the team's fallback teams in priority
+// order.
+func loadFallbackTeams(ctx context.Context, q querier, teamID int64) ([]string, error) {
+	rows, err := q.Query(ctx, `
+		SELECT t.name
+		FROM team_fallbacks tf
+		JOIN teams t ON t.id = tf.fallback_team_id
+		WHERE tf.team_id = $1
+		ORDER BY tf.priority`,
+		teamID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// saveFallbackTeams replaces the team's fallback list. The first name gets the
+// highest priority.
+func saveFallbackTeams(ctx context.Context, q querier, teamID int64, names []string) error {
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		id, err := lookupTeamID(ctx, q, name)
+		if errors.Is(err, ErrTeamNotFound) {
+			return ErrInvalidFallbackTeams
+		}
+		if err != nil {
+			return err
+		}
+		if id == teamID {
+			return ErrInvalidFallbackTeams
+		}
+		ids = append(ids, id)
+	}
+
+	if _, err := q.Exec(ctx, `DELETE FROM team_fallbacks WHERE team_id = $1`, teamID); err != nil {
+		return err
+	}
+	for priority, id := range ids {
+		if _, err := q.Exec(ctx, `
+			INSERT INTO team_fallbacks (team_id, fallback_team_id, priority)
+			VALUES ($1, $2, $3)`,
+			teamID, id, priority,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectFromFallbacks fills up to count reviewer slots from the fallback teams
+// of the pull request's team, walking them in priority order, and then
+// escalates up the team tree through the team's parent, its parent and so on.
+// Each of these teams is drawn from with its own strategy and capacity, and
+// only members below their capacity are considered. Archived teams are
+// skipped.
+func (r *Repository) selectFromFallbacks(ctx context.Context, tx pgx.Tx, pr PullRequest, exclude []string, count int) ([]pick, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT tf.fallback_team_id
+		FROM team_fallbacks tf
+		JOIN teams t ON t.id = tf.fallback_team_id
+		WHERE tf.team_id = $1
+		  AND t.archived_at IS NULL
+		ORDER BY tf.priority`,
+		pr.TeamID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teamIDs []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		teamIDs = append(teamIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ancestors, err := loadActiveAncestorIDs(ctx, tx, pr.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ancestors {
+		if !slices.Contains(teamIDs, id) {
+			teamIDs = append(teamIDs, id)
+		}
+	}
+
+	var picks []pick
+	for _, teamID := range teamIDs {
+		missing := count - len(picks)
+		if missing == 0 {
+			break
+		}
+
+		settings, err := r.loadTeamSettings(ctx, tx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		candidates, err := loadCandidates(ctx, tx, teamID, exclude, settings.MaxOpenReviews)
+		if err != nil {
+			return nil, err
+		}
+		var available []candidate
+		for _, c := range candidates {
+			if !c.atCapacity() {
+				available = append(available, c)
+			}
+		}
+
+		reviewers, err := r.pickReviewers(ctx, tx, pr, teamID, settings, available, missing)
+		if err != nil {
+			return nil, err
+		}
+		fallbackTeamID := teamID
+		for _, id := range reviewers {
+			picks = append(picks, pick{UserID: id, FallbackTeamID: &fallbackTeamID})
+		}
+		exclude = append(exclude, reviewers...)
+	}
+	return picks, nil
+}
@@ -0,0 +1,208 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// upsertMember creates the user as a member of the team, or updates an
+// existing user and moves them into it. Changing the active flag drops the
+// deactivation time set by UpdateUserActive. It reports whether an active user
+// was deactivated, in which case the caller hands their reviews over.
+func upsertMember(ctx context.Context, tx pgx.Tx, teamID int64, m TeamMember) (bool, error) {
+	var deactivated bool
+	err := tx.QueryRow(ctx, `
+		WITH previous AS (
+			SELECT is_active FROM users WHERE id = $1
+		)
+		INSERT INTO users (id, username, is_active, team_id, max_open_reviews)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE
+		SET username = EXCLUDED.username,
+		    team_id = EXCLUDED.team_id,
+		    is_active = EXCLUDED.is_active,
+		    deactivated_at = CASE WHEN EXCLUDED.is_active = users.is_active THEN users.deactivated_at END,
+		    max_open_reviews = COALESCE(EXCLUDED.max_open_reviews, users.max_open_reviews),
+		    updated_at = NOW()
+		RETURNING COALESCE((SELECT is_active FROM previous), FALSE) AND NOT is_active`,
+		m.ID, m.Username, m.IsActive, teamID, m.MaxOpenReviews).Scan(&deactivated)
+	return deactivated, err
+}
+
+// lockUserTeam locks the user's row and returns their team, nil when the user
+// belongs to no team.
+func lockUserTeam(ctx context.Context, tx pgx.Tx, userID string) (*int64, error) {
+	var teamID *int64
+	if err := tx.QueryRow(ctx, `SELECT team_id FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&teamID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return teamID, nil
+}
+
+// recordMembershipMove appends a move of the user between teams to the
+// membership history. A nil team stands for no team. It must run in the
+// transaction that moves the user.
+func recordMembershipMove(ctx context.Context, tx pgx.Tx, userID string, from, to *int64, audit Audit) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO team_membership_events (user_id, from_team, to_team, actor, reason)
+		VALUES ($1, (SELECT name FROM teams WHERE id = $2), (SELECT name FROM teams WHERE id = $3), $4, $5)`,
+		userID, from, to, audit.Actor, audit.Reason,
+	)
+	return err
+}
+
+// AddTeamMembers adds users to an existing team, creating unknown users and
+// updating the details of those already in it. Users that belong to another
+// team are refused with ErrUserInOtherTeam; MoveTeamMember moves them. Members
+// deactivated this way hand their OPEN reviews over as with UpdateUserActive.
+func (r *Repository) AddTeamMembers(ctx context.Context, teamName string, members []TeamMember) (Team, error) {
+	for _, m := range members {
+		if m.MaxOpenReviews != nil && *m.MaxOpenReviews < 1 {
+			return Team{}, ErrInvalidCapacity
+		}
+	}
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Team{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	teamID, err := lookupActiveTeamID(ctx, tx, teamName)
+	if err != nil {
+		return Team{}, err
+	}
+
+	var deactivated []string
+	for _, m := range members {
+		if m.ID == "" {
+			continue
+		}
+		current, err := lockUserTeam(ctx, tx, m.ID)
+		if err != nil && !errors.Is(err, ErrUserNotFound) {
+			return Team{}, err
+		}
+		if current != nil && *current != teamID {
+			return Team{}, fmt.Errorf("%w: %s", ErrUserInOtherTeam, m.ID)
+		}
+		wasDeactivated, err := upsertMember(ctx, tx, teamID, m)
+		if err != nil {
+			return Team{}, err
+		}
+		if wasDeactivated {
+			deactivated = append(deactivated, m.ID)
+		}
+	}
+	for _, userID := range deactivated {
+		if _, err := r.handOverReviews(ctx, tx, userID, nil, "reviewer deactivated"); err != nil {
+			return Team{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Team{}, err
+	}
+	return r.GetTeam(ctx, teamName)
+}
+
+// RemoveTeamMember takes the user out of the team, leaving them without one,
+// and hands each of their OPEN reviews over to another candidate. The removal
+// is recorded in the membership history with the audit details.
+func (r *Repository) RemoveTeamMember(ctx context.Context, teamName, userID string, audit Audit) (Team, ReviewHandover, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Team{}, ReviewHandover{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	teamID, err := lookupTeamID(ctx, tx, teamName)
+	if err != nil {
+		return Team{}, ReviewHandover{}, err
+	}
+	current, err := lockUserTeam(ctx, tx, userID)
+	if err != nil {
+		return Team{}, ReviewHandover{}, err
+	}
+	if current == nil || *current != teamID {
+		return Team{}, ReviewHandover{}, ErrUserNotInTeam
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE users
+		SET team_id = NULL,
+		    updated_at = NOW()
+		WHERE id = $1`,
+		userID,
+	); err != nil {
+		return Team{}, ReviewHandover{}, err
+	}
+	if err := recordMembershipMove(ctx, tx, userID, current, nil, audit); err != nil {
+		return Team{}, ReviewHandover{}, err
+	}
+	handover, err := r.handOverReviews(ctx, tx, userID, nil, "reviewer removed from team")
+	if err != nil {
+		return Team{}, ReviewHandover{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Team{}, ReviewHandover{}, err
+	}
+	team, err := r.GetTeam(ctx, teamName)
+	return team, handover, err
+}
+
+// MoveTeamMember moves the user into the team. Their OPEN reviews on pull
+// requests of the team they leave are handed over to another candidate;
+// moving a user into the team they are already in changes nothing. The move
+// is recorded in the membership history with the audit details.
+func (r *Repository) MoveTeamMember(ctx context.Context, userID, teamName string, audit Audit) (User, ReviewHandover, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return User{}, ReviewHandover{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	teamID, err := lookupActiveTeamID(ctx, tx, teamName)
+	if err != nil {
+		return User{}, ReviewHandover{}, err
+	}
+	previous, err := lockUserTeam(ctx, tx, userID)
+	if err != nil {
+		return User{}, ReviewHandover{}, err
+	}
+
+	u, err := scanUser(tx.QueryRow(ctx, `
+		UPDATE users
+		SET team_id = $2,
+		    updated_at = CASE WHEN team_id IS DISTINCT FROM $2 THEN NOW() ELSE updated_at END
+		WHERE id = $1
+		RETURNING `+userReturning,
+		userID, teamID,
+	))
+	if err != nil {
+		return User{}, ReviewHandover{}, err
+	}
+
+	var handover ReviewHandover
+	if previous == nil || *previous != teamID {
+		if err := recordMembershipMove(ctx, tx, userID, previous, &teamID, audit); err != nil {
+			return User{}, ReviewHandover{}, err
+		}
+		if previous != nil {
+			if handover, err = r.handOverReviews(ctx, tx, userID, previous, "reviewer moved to another team"); err != nil {
+				return User{}, ReviewHandover{}, err
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return User{}, ReviewHandover{}, err
+	}
+	return u, handover, nil
+}
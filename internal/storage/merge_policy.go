@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// mergePolicyViolation checks the pull request's current reviews against the
+// team's merge policy and describes what is missing; an empty result means
+// the pull request may be merged.
+func mergePolicyViolation(ctx context.Context, tx pgx.Tx, pr PullRequest, settings TeamSettings) (string, error) {
+	if settings.RequiredApprovals == 0 && !settings.BlockOnChangesRequested {
+		return "", nil
+	}
+
+	var approvals, changesRequested int
+	err := tx.QueryRow(ctx, `
+		SELECT COUNT(*) FILTER (WHERE verdict = 'APPROVED'),
+		       COUNT(*) FILTER (WHERE verdict = 'CHANGES_REQUESTED')
+		FROM pull_request_reviewers
+		WHERE pull_request_id = $1`,
+		pr.ID,
+	).Scan(&approvals, &changesRequested)
+	if err != nil {
+		return "", err
+	}
+
+	var problems []string
+	if approvals < settings.RequiredApprovals {
+		problems = append(problems, fmt.Sprintf("%d of %d required approvals", approvals, settings.RequiredApprovals))
+	}
+	if settings.BlockOnChangesRequested && changesRequested > 0 {
+		problems = append(problems, fmt.Sprintf("%d outstanding change requests", changesRequested))
+	}
+	return strings.Join(problems, ", "), nil
+}
+
+// recordMergeOverride stores a merge that went through despite a policy
+// violation.
+func recordMergeOverride(ctx context.Context, tx pgx.Tx, pullRequestID, violation string, audit Audit) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO merge_overrides (pull_request_id, violation, actor, reason)
+		VALUES ($1, $2, $3, $4)`,
+		pullRequestID, violation, audit.Actor, audit.Reason,
+	)
+	return err
+}
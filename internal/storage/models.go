@@ -6,18 +6,84 @@ type TeamMember struct {
 	ID       string
 	Username string
 	IsActive bool
+	// MaxOpenReviews overrides the team's capacity; nil means the team default.
+	MaxOpenReviews *int
+}
+
+// TeamSettings holds the effective assignment policy of a team. Settings the
+// team does not set itself are inherited from its parent team, and top-level
+// teams fall back to the defaults; fallback teams are never inherited.
+type TeamSettings struct {
+	AssignmentStrategy string
+	MinReviewers       int
+	MaxReviewers       int
+	// MaxOpenReviews is the default review capacity of members; 0 means unlimited.
+	MaxOpenReviews   int
+	CapacityOverflow string
+	// PreferWorkingHours makes assignment try members inside their working
+	// hours first.
+	PreferWorkingHours bool
+	// FallbackTeams are drawn from, in order, when the team runs out of
+	// candidates.
+	FallbackTeams []string
+	// RequiredApprovals is the number of APPROVED verdicts a pull request
+	// needs before it can be merged; 0 disables the check.
+	RequiredApprovals       int
+	BlockOnChangesRequested bool
+}
+
+// TeamSettingsPatch lists settings to change; nil fields are left as is.
+type TeamSettingsPatch struct {
+	AssignmentStrategy      *string
+	MinReviewers            *int
+	MaxReviewers            *int
+	MaxOpenReviews          *int
+	CapacityOverflow        *string
+	PreferWorkingHours      *bool
+	FallbackTeams           *[]string
+	RequiredApprovals       *int
+	BlockOnChangesRequested *bool
 }
 
 type Team struct {
-	Name    string
-	Members []TeamMember
+	// ID stays the same when the team is renamed.
+	ID       int64
+	Name     string
+	Settings TeamSettings
+	Members  []TeamMember
+	// ArchivedAt is nil while the team is active.
+	ArchivedAt *time.Time
+	// ParentID is nil and ParentName empty for a top-level team.
+	ParentID   *int64
+	ParentName string
+}
+
+// TeamNode is a team in the team tree together with its sub-teams.
+type TeamNode struct {
+	ID         int64
+	Name       string
+	ArchivedAt *time.Time
+	SubTeams   []TeamNode
 }
 
 type User struct {
 	ID       string
 	Name     string
 	IsActive bool
-	TeamName string
+	// TeamID is nil and TeamName empty when the user belongs to no team.
+	TeamID         *int64
+	TeamName       string
+	MaxOpenReviews *int
+	// WorkingHours is nil when the user has no schedule.
+	WorkingHours *WorkingHours
+}
+
+// WorkingHours is a daily on-duty window given as HH:MM clock times in the
+// user's time zone.
+type WorkingHours struct {
+	Timezone string
+	Start    string
+	End      string
 }
 
 type PullRequest struct {
@@ -28,14 +94,83 @@ type PullRequest struct {
 	TeamName          string
 	Status            string
 	CreatedAt         time.Time
+	DraftedAt         *time.Time
+	OpenedAt          *time.Time
 	MergedAt          *time.Time
+	ClosedAt          *time.Time
 	AssignedReviewers []string
+	// FallbackReviewers lists the assigned reviewers that come from a fallback
+	// team.
+	FallbackReviewers []FallbackReviewer
+	// Reviews holds the verdict of every assigned reviewer, in assignment
+	// order.
+	Reviews []Review
+}
+
+// Review is an assigned reviewer's verdict; Verdict is nil while the review is
+// pending.
+type Review struct {
+	ReviewerID  string
+	Verdict     *string
+	SubmittedAt *time.Time
+}
+
+type FallbackReviewer struct {
+	UserID   string
+	TeamID   int64
+	TeamName string
+}
+
+// AssignmentSummary describes how the reviewer selection for a pull request
+// went compared to the team's limits.
+type AssignmentSummary struct {
+	MinReviewers int
+	Understaffed bool
+}
+
+type Reassignment struct {
+	PullRequestID string
+	ReplacedBy    string
+	// ExclusionRelaxed is set when nobody but reviewers previously removed
+	// from the pull request was available, so one of them was picked again.
+	ExclusionRelaxed bool
+}
+
+// ReviewHandover lists what happened to the OPEN reviews a user had to give
+// up: on deactivation, on leaving or moving out of a team, and in a team sync.
+// WithoutCandidate holds the pull requests where nobody could take over and
+// the user stays assigned.
+type ReviewHandover struct {
+	Reassigned       []Reassignment
+	WithoutCandidate []string
+}
+
+// Unavailability is a period during which a user receives no new reviews.
+type Unavailability struct {
+	ID          int64
+	UserID      string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	Reason      string
+	CreatedAt   time.Time
+	CancelledAt *time.Time
+}
+
+// Audit describes who asked for a change and why.
+type Audit struct {
+	Actor  string
+	Reason string
 }
 
-type PullRequestShort struct {
-	ID        string
-	Name      string
-	AuthorID  string
-	Status    string
-	CreatedAt time.Time
+// AssignmentEvent is an entry of a pull request's reviewer history. For
+// reassignments PreviousReviewerID holds the reviewer that was replaced.
+type AssignmentEvent struct {
+	ID                 int64
+	PullRequestID      string
+	Type               string
+	ReviewerID         string
+	PreviousReviewerID *string
+	Actor              string
+	Reason             string
+	CreatedAt          time.Time
 }
@@ -0,0 +1,233 @@
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+)
+
+// PullRequestFilter selects pull requests for ListPullRequests. Zero fields
+// do not filter.
+type PullRequestFilter struct {
+	TeamName string
+	AuthorID string
+	Status   string
+	// CreatedFrom and CreatedTo bound the creation time; the lower bound is
+	// inclusive and the upper one exclusive.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Limit       int
+	// Cursor is the NextCursor of the previous page.
+	Cursor string
+}
+
+// ReviewFilter selects the pull requests returned by
+// ListPullRequestsByReviewer. Zero fields do not filter.
+type ReviewFilter struct {
+	// PendingOnly keeps OPEN pull requests the reviewer has not yet submitted
+	// a verdict on.
+	PendingOnly bool
+	Status      string
+	// Since keeps pull requests created at or after this time.
+	Since time.Time
+	Limit int
+	// Cursor is the NextCursor of the previous page.
+	Cursor string
+	// WithReviewers loads the reviewers and verdicts of every pull request;
+	// without it only the pull request rows are read.
+	WithReviewers bool
+}
+
+// PullRequestPage is one page of ListPullRequests or
+// ListPullRequestsByReviewer. NextCursor is empty on the last page.
+type PullRequestPage struct {
+	PullRequests []PullRequest
+	NextCursor   string
+}
+
+// pullRequestCursor is the position after the last pull request of a page in
+// the newest-first ordering used by ListPullRequests.
+type pullRequestCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+func (c pullRequestCursor) encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePullRequestCursor(s string) (pullRequestCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return pullRequestCursor{}, ErrInvalidCursor
+	}
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return pullRequestCursor{}, ErrInvalidCursor
+	}
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return pullRequestCursor{}, ErrInvalidCursor
+	}
+	return pullRequestCursor{CreatedAt: t, ID: id}, nil
+}
+
+// ListPullRequests returns the pull requests matching the filter, newest
+// first, with ties broken by id so that pages never overlap or skip rows.
+func (r *Repository) ListPullRequests(ctx context.Context, filter PullRequestFilter) (PullRequestPage, error) {
+	if filter.Status != "" && !isPullRequestStatus(filter.Status) {
+		return PullRequestPage{}, ErrInvalidStatusFilter
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		return PullRequestPage{}, ErrInvalidDateRange
+	}
+
+	var teamID *int64
+	if filter.TeamName != "" {
+		id, err := lookupTeamID(ctx, r.pool, filter.TeamName)
+		if err != nil {
+			return PullRequestPage{}, err
+		}
+		teamID = &id
+	}
+	var (
+		afterCreatedAt *time.Time
+		afterID        string
+	)
+	if filter.Cursor != "" {
+		cursor, err := decodePullRequestCursor(filter.Cursor)
+		if err != nil {
+			return PullRequestPage{}, err
+		}
+		afterCreatedAt, afterID = &cursor.CreatedAt, cursor.ID
+	}
+
+	// One extra row tells whether there is a next page.
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+pullRequestColumns+`
+		FROM pull_requests pr
+		JOIN teams t ON t.id = pr.team_id
+		WHERE ($1::int IS NULL OR pr.team_id = $1)
+		  AND ($2 = '' OR pr.author_id = $2)
+		  AND ($3 = '' OR pr.status = $3)
+		  AND ($4::timestamptz IS NULL OR pr.created_at >= $4)
+		  AND ($5::timestamptz IS NULL OR pr.created_at < $5)
+		  AND ($6::timestamptz IS NULL OR (pr.created_at, pr.id) < ($6, $7))
+		ORDER BY pr.created_at DESC, pr.id DESC
+		LIMIT $8`,
+		teamID,
+		filter.AuthorID,
+		filter.Status,
+		nullTime(filter.CreatedFrom),
+		nullTime(filter.CreatedTo),
+		afterCreatedAt,
+		afterID,
+		filter.Limit+1,
+	)
+	if err != nil {
+		return PullRequestPage{}, err
+	}
+	defer rows.Close()
+
+	var page PullRequestPage
+	for rows.Next() {
+		pr, err := scanPullRequest(rows)
+		if err != nil {
+			return PullRequestPage{}, err
+		}
+		page.PullRequests = append(page.PullRequests, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return PullRequestPage{}, err
+	}
+
+	page.trim(filter.Limit)
+	if err := loadReviewers(ctx, r.pool, page.PullRequests); err != nil {
+		return PullRequestPage{}, err
+	}
+	return page, nil
+}
+
+// ListPullRequestsByReviewer returns the pull requests the user is assigned to
+// review, in the same order and with the same cursors as ListPullRequests.
+func (r *Repository) ListPullRequestsByReviewer(ctx context.Context, reviewerID string, filter ReviewFilter) (PullRequestPage, error) {
+	if filter.Status != "" && !isPullRequestStatus(filter.Status) {
+		return PullRequestPage{}, ErrInvalidStatusFilter
+	}
+	var (
+		afterCreatedAt *time.Time
+		afterID        string
+	)
+	if filter.Cursor != "" {
+		cursor, err := decodePullRequestCursor(filter.Cursor)
+		if err != nil {
+			return PullRequestPage{}, err
+		}
+		afterCreatedAt, afterID = &cursor.CreatedAt, cursor.ID
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+pullRequestColumns+`
+		FROM pull_requests pr
+		JOIN teams t ON t.id = pr.team_id
+		JOIN pull_request_reviewers rvr ON rvr.pull_request_id = pr.id
+		WHERE rvr.reviewer_id = $1
+		  AND (NOT $2 OR (pr.status = 'OPEN' AND rvr.verdict IS NULL))
+		  AND ($3 = '' OR pr.status = $3)
+		  AND ($4::timestamptz IS NULL OR pr.created_at >= $4)
+		  AND ($5::timestamptz IS NULL OR (pr.created_at, pr.id) < ($5, $6))
+		ORDER BY pr.created_at DESC, pr.id DESC
+		LIMIT $7`,
+		reviewerID,
+		filter.PendingOnly,
+		filter.Status,
+		nullTime(filter.Since),
+		afterCreatedAt,
+		afterID,
+		filter.Limit+1,
+	)
+	if err != nil {
+		return PullRequestPage{}, err
+	}
+	defer rows.Close()
+
+	var page PullRequestPage
+	for rows.Next() {
+		pr, err := scanPullRequest(rows)
+		if err != nil {
+			return PullRequestPage{}, err
+		}
+		page.PullRequests = append(page.PullRequests, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return PullRequestPage{}, err
+	}
+
+	page.trim(filter.Limit)
+	if filter.WithReviewers {
+		if err := loadReviewers(ctx, r.pool, page.PullRequests); err != nil {
+			return PullRequestPage{}, err
+		}
+	}
+	return page, nil
+}
+
+// trim cuts the page, read with one extra row, down to limit and sets
+// NextCursor if the extra row was there.
+func (p *PullRequestPage) trim(limit int) {
+	if len(p.PullRequests) <= limit {
+		return
+	}
+	p.PullRequests = p.PullRequests[:limit]
+	last := p.PullRequests[len(p.PullRequests)-1]
+	p.NextCursor = pullRequestCursor{CreatedAt: last.CreatedAt, ID: last.ID}.encode()
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
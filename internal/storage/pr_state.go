@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Pull request statuses. Which moves between them are allowed is decided by
+// the service layer; storage only guards against concurrent changes.
+const (
+	StatusDraft  = "DRAFT"
+	StatusOpen   = "OPEN"
+	StatusMerged = "MERGED"
+	StatusClosed = "CLOSED"
+)
+
+func isPullRequestStatus(status string) bool {
+	switch status {
+	case StatusDraft, StatusOpen, StatusMerged, StatusClosed:
+		return true
+	}
+	return false
+}
+
+// pullRequestColumns lists the pull request columns read by
+// scanPullRequest; the query must alias pull_requests as pr and teams as t.
+const pullRequestColumns = `pr.id, pr.name, pr.author_id, pr.team_id, t.name, pr.status,
+	pr.created_at, pr.drafted_at, pr.opened_at, pr.merged_at, pr.closed_at`
+
+func scanPullRequest(row pgx.Row) (PullRequest, error) {
+	var pr PullRequest
+	err := row.Scan(
+		&pr.ID,
+		&pr.Name,
+		&pr.AuthorID,
+		&pr.TeamID,
+		&pr.TeamName,
+		&pr.Status,
+		&pr.CreatedAt,
+		&pr.DraftedAt,
+		&pr.OpenedAt,
+		&pr.MergedAt,
+		&pr.ClosedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PullRequest{}, ErrPullRequestNotFound
+		}
+		return PullRequest{}, err
+	}
+	return pr, nil
+}
+
+// TransitionPullRequest moves the pull request from one status to another
+// and stamps the time it entered the new status. It fails with
+// ErrPullRequestStateChanged when the pull request is no longer in from, and
+// with ErrTeamArchived when it would become DRAFT or OPEN in an archived team.
+func (r *Repository) TransitionPullRequest(ctx context.Context, id, from, to string) (PullRequest, error) {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE pull_requests
+		SET status = $3,
+		    drafted_at = CASE WHEN $3 = 'DRAFT' THEN NOW() ELSE drafted_at END,
+		    opened_at = CASE WHEN $3 = 'OPEN' THEN NOW() ELSE opened_at END,
+		    merged_at = CASE WHEN $3 = 'MERGED' THEN NOW() ELSE merged_at END,
+		    closed_at = CASE WHEN $3 = 'CLOSED' THEN NOW() ELSE closed_at END
+		WHERE id = $1 AND status = $2
+		  AND ($3 NOT IN ('DRAFT', 'OPEN') OR NOT EXISTS (
+		      SELECT 1 FROM teams t WHERE t.id = pull_requests.team_id AND t.archived_at IS NOT NULL
+		  ))`,
+		id, from, to,
+	)
+	if err != nil {
+		return PullRequest{}, err
+	}
+	if tag.RowsAffected() == 0 {
+		pr, err := r.GetPullRequest(ctx, id)
+		if err != nil {
+			return PullRequest{}, err
+		}
+		if pr.Status == from {
+			return PullRequest{}, ErrTeamArchived
+		}
+		return PullRequest{}, ErrPullRequestStateChanged
+	}
+	return r.GetPullRequest(ctx, id)
+}
+
+// requireOpen reports why reviewers of a pull request in the given status
+// cannot be changed, if they cannot.
+func requireOpen(status string) error {
+	switch status {
+	case StatusOpen:
+		return nil
+	case StatusMerged:
+		return ErrPullRequestMerged
+	default:
+		return ErrPullRequestNotOpen
+	}
+}
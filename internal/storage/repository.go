@@ -3,35 +3,83 @@ package storage
 import (
 	"context"
 	"errors"
-	"math/rand"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/avito/pr-reviewer-assignment-service/internal/assignment"
 )
 
 type Repository struct {
-	pool *pgxpool.Pool
-	rng  *rand.Rand
+	pool       *pgxpool.Pool
+	strategies assignment.Registry
+}
+
+// querier is implemented by both *pgxpool.Pool and pgx.Tx.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
 func NewRepository(pool *pgxpool.Pool) *Repository {
 	return &Repository{
-		pool: pool,
-		rng:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		pool:       pool,
+		strategies: assignment.Builtin(),
 	}
 }
 
-func (r *Repository) CreateTeam(ctx context.Context, name string, members []TeamMember) (Team, error) {
+// CreateTeam creates the team with its members, creating unknown users and
+// updating existing ones. Members that belong to another team are refused
+// with ErrUserInOtherTeam unless allowMove is set; allowed moves are recorded
+// in the membership history and hand over the user's OPEN reviews on pull
+// requests of the team they leave; members deactivated this way hand over all
+// of them. A non-empty parentName nests the team under that active team, whose
+// settings it inherits.
+func (r *Repository) CreateTeam(ctx context.Context, name, parentName string, patch TeamSettingsPatch, members []TeamMember, allowMove bool, audit Audit) (Team, error) {
+	for _, m := range members {
+		if m.MaxOpenReviews != nil && *m.MaxOpenReviews < 1 {
+			return Team{}, ErrInvalidCapacity
+		}
+	}
+
 	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return Team{}, err
 	}
 	defer tx.Rollback(ctx)
 
+	if _, err := lookupTeamID(ctx, tx, name); !errors.Is(err, ErrTeamNotFound) {
+		if err == nil {
+			return Team{}, ErrTeamExists
+		}
+		return Team{}, err
+	}
+
+	var parentID *int64
+	if parentName != "" {
+		id, err := lookupActiveTeamID(ctx, tx, parentName)
+		if err != nil {
+			return Team{}, err
+		}
+		parentID = &id
+	}
+	settings, err := r.inheritedSettings(ctx, tx, parentID)
+	if err != nil {
+		return Team{}, err
+	}
+	patch.applyTo(&settings)
+	if err := r.validateSettings(settings); err != nil {
+		return Team{}, err
+	}
+
 	var teamID int64
-	if err := tx.QueryRow(ctx, `INSERT INTO teams (name) VALUES ($1) RETURNING id`, name).Scan(&teamID); err != nil {
+	if err := tx.QueryRow(ctx, `INSERT INTO teams (name, parent_id) VALUES ($1, $2) RETURNING id`, name, parentID).Scan(&teamID); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
 			return Team{}, ErrTeamExists
@@ -39,22 +87,43 @@ func (r *Repository) CreateTeam(ctx context.Context, name string, members []Team
 		return Team{}, err
 	}
 
+	if err := saveTeamSettings(ctx, tx, teamID, patch); err != nil {
+		return Team{}, err
+	}
+
+	var handovers []pendingHandover
 	for _, m := range members {
 		if m.ID == "" {
 			continue
 		}
-		_, err = tx.Exec(ctx, `
-			INSERT INTO users (id, username, is_active, team_id)
-			VALUES ($1, $2, $3, $4)
-			ON CONFLICT (id) DO UPDATE
-			SET username = EXCLUDED.username,
-			    team_id = EXCLUDED.team_id,
-			    is_active = EXCLUDED.is_active,
-			    updated_at = NOW()`,
-			m.ID, m.Username, m.IsActive, teamID)
+		previous, err := lockUserTeam(ctx, tx, m.ID)
+		if err != nil && !errors.Is(err, ErrUserNotFound) {
+			return Team{}, err
+		}
+		moved := previous != nil && *previous != teamID
+		if moved {
+			if !allowMove {
+				return Team{}, fmt.Errorf("%w: %s", ErrUserInOtherTeam, m.ID)
+			}
+			if err := recordMembershipMove(ctx, tx, m.ID, previous, &teamID, audit); err != nil {
+				return Team{}, err
+			}
+		}
+		deactivated, err := upsertMember(ctx, tx, teamID, m)
 		if err != nil {
 			return Team{}, err
 		}
+		switch {
+		case deactivated:
+			handovers = append(handovers, pendingHandover{m.ID, nil, "reviewer deactivated"})
+		case moved:
+			handovers = append(handovers, pendingHandover{m.ID, previous, "reviewer moved to another team"})
+		}
+	}
+	for _, h := range handovers {
+		if _, err := r.handOverReviews(ctx, tx, h.userID, h.teamID, h.reason); err != nil {
+			return Team{}, err
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -64,19 +133,35 @@ func (r *Repository) CreateTeam(ctx context.Context, name string, members []Team
 	return r.GetTeam(ctx, name)
 }
 
+// GetTeam returns the team under its current name, even when it is looked up
+// by a former one.
 func (r *Repository) GetTeam(ctx context.Context, name string) (Team, error) {
+	teamID, err := lookupTeamID(ctx, r.pool, name)
+	if err != nil {
+		return Team{}, err
+	}
 	var (
-		teamID int64
+		archivedAt *time.Time
+		parentID   *int64
+		parentName *string
 	)
-	if err := r.pool.QueryRow(ctx, `SELECT id FROM teams WHERE name = $1`, name).Scan(&teamID); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return Team{}, ErrTeamNotFound
-		}
+	if err := r.pool.QueryRow(ctx, `
+		SELECT t.name, t.archived_at, t.parent_id, p.name
+		FROM teams t
+		LEFT JOIN teams p ON p.id = t.parent_id
+		WHERE t.id = $1`,
+		teamID,
+	).Scan(&name, &archivedAt, &parentID, &parentName); err != nil {
+		return Team{}, err
+	}
+
+	settings, err := r.loadTeamSettings(ctx, r.pool, teamID)
+	if err != nil {
 		return Team{}, err
 	}
 
 	rows, err := r.pool.Query(ctx, `
-		SELECT id, username, is_active
+		SELECT id, username, is_active, max_open_reviews
 		FROM users
 		WHERE team_id = $1
 		ORDER BY username`, teamID)
@@ -88,26 +173,205 @@ func (r *Repository) GetTeam(ctx context.Context, name string) (Team, error) {
 	var members []TeamMember
 	for rows.Next() {
 		var tm TeamMember
-		if err := rows.Scan(&tm.ID, &tm.Username, &tm.IsActive); err != nil {
+		if err := rows.Scan(&tm.ID, &tm.Username, &tm.IsActive, &tm.MaxOpenReviews); err != nil {
 			return Team{}, err
 		}
 		members = append(members, tm)
 	}
 
-	return Team{Name: name, Members: members}, rows.Err()
+	team := Team{ID: teamID, Name: name, Settings: settings, Members: members, ArchivedAt: archivedAt, ParentID: parentID}
+	if parentName != nil {
+		team.ParentName = *parentName
+	}
+	return team, rows.Err()
+}
+
+func (r *Repository) UpdateTeamSettings(ctx context.Context, name string, patch TeamSettingsPatch) (Team, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Team{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	teamID, err := lockActiveTeamID(ctx, tx, name)
+	if err != nil {
+		return Team{}, err
+	}
+
+	settings, err := r.loadTeamSettings(ctx, tx, teamID)
+	if err != nil {
+		return Team{}, err
+	}
+	patch.applyTo(&settings)
+	if err := r.validateSettings(settings); err != nil {
+		return Team{}, err
+	}
+
+	if err := saveTeamSettings(ctx, tx, teamID, patch); err != nil {
+		return Team{}, err
+	}
+	if err := r.validateSubTeamSettings(ctx, tx, teamID); err != nil {
+		return Team{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Team{}, err
+	}
+
+	return r.GetTeam(ctx, name)
 }
 
-func (r *Repository) UpdateUserActive(ctx context.Context, userID string, active bool) (User, error) {
-	var u User
-	err := r.pool.QueryRow(ctx, `
+// userReturning lists the user columns returned by statements on the users
+// table; scanUser reads them back.
+const userReturning = `id, username, is_active, team_id,
+	COALESCE((SELECT name FROM teams WHERE teams.id = users.team_id), '') AS team_name,
+	max_open_reviews,
+	timezone, to_char(work_start, 'HH24:MI'), to_char(work_end, 'HH24:MI')`
+
+func scanUser(row pgx.Row) (User, error) {
+	var (
+		u                    User
+		timezone, start, end *string
+	)
+	if err := row.Scan(&u.ID, &u.Name, &u.IsActive, &u.TeamID, &u.TeamName, &u.MaxOpenReviews, &timezone, &start, &end); err != nil {
+		return User{}, err
+	}
+	u.WorkingHours = workingHours(timezone, start, end)
+	return u, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, userID string) (User, error) {
+	u, err := scanUser(r.pool.QueryRow(ctx, `
+		SELECT `+userReturning+`
+		FROM users
+		WHERE id = $1`,
+		userID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, err
+	}
+	return u, nil
+}
+
+// UpdateUserActive sets the user's active flag. Deactivating a user hands each
+// of their OPEN reviews over to another candidate within the same transaction;
+// reviews without a candidate stay assigned and are reported in the handover.
+// The deactivation time is kept so that ReactivateAfterUnavailability can tell
+// it apart from deactivations made elsewhere.
+func (r *Repository) UpdateUserActive(ctx context.Context, userID string, active bool) (User, ReviewHandover, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return User{}, ReviewHandover{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	u, err := scanUser(tx.QueryRow(ctx, `
 		UPDATE users
 		SET is_active = $2,
+		    deactivated_at = CASE WHEN $2 THEN NULL ELSE NOW() END,
 		    updated_at = NOW()
 		WHERE id = $1
-		RETURNING id, username, is_active,
-			(SELECT name FROM teams WHERE teams.id = users.team_id) AS team_name`,
+		RETURNING `+userReturning,
 		userID, active,
-	).Scan(&u.ID, &u.Name, &u.IsActive, &u.TeamName)
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return User{}, ReviewHandover{}, ErrUserNotFound
+		}
+		return User{}, ReviewHandover{}, err
+	}
+
+	var handover ReviewHandover
+	if !active {
+		if handover, err = r.handOverReviews(ctx, tx, userID, nil, "reviewer deactivated"); err != nil {
+			return User{}, ReviewHandover{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return User{}, ReviewHandover{}, err
+	}
+	return u, handover, nil
+}
+
+// handOverReviews reassigns the OPEN reviews of the user, limited to the pull
+// requests of one team when teamID is set. Pull requests that stopped being
+// OPEN, or no longer have the user as a reviewer, by the time they are locked
+// are skipped.
+func (r *Repository) handOverReviews(ctx context.Context, tx pgx.Tx, userID string, teamID *int64, reason string) (ReviewHandover, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT pr.id
+		FROM pull_requests pr
+		JOIN pull_request_reviewers rvr ON rvr.pull_request_id = pr.id
+		WHERE rvr.reviewer_id = $1
+		  AND pr.status = 'OPEN'
+		  AND ($2::int IS NULL OR pr.team_id = $2)
+		ORDER BY pr.created_at, pr.id`,
+		userID, teamID,
+	)
+	if err != nil {
+		return ReviewHandover{}, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return ReviewHandover{}, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return ReviewHandover{}, err
+	}
+
+	var handover ReviewHandover
+	for _, id := range ids {
+		pr, err := lockPullRequest(ctx, tx, id)
+		if err != nil {
+			return ReviewHandover{}, err
+		}
+		if pr.Status != StatusOpen {
+			continue
+		}
+
+		reassignment, err := r.replaceReviewer(ctx, tx, pr, userID, "", Audit{
+			Actor:  ActorSystem,
+			Reason: reason,
+		})
+		switch {
+		case err == nil:
+			handover.Reassigned = append(handover.Reassigned, reassignment)
+		case errors.Is(err, ErrReviewerNotAssigned):
+			// Already taken off the pull request concurrently.
+		case errors.Is(err, ErrNoReviewerCandidate), errors.Is(err, ErrCapacityExceeded):
+			handover.WithoutCandidate = append(handover.WithoutCandidate, id)
+		default:
+			return ReviewHandover{}, err
+		}
+	}
+	return handover, nil
+}
+
+// UpdateUserMaxOpenReviews sets the user's personal review capacity. A nil
+// limit makes the user fall back to the team default.
+func (r *Repository) UpdateUserMaxOpenReviews(ctx context.Context, userID string, limit *int) (User, error) {
+	if limit != nil && *limit < 1 {
+		return User{}, ErrInvalidCapacity
+	}
+
+	u, err := scanUser(r.pool.QueryRow(ctx, `
+		UPDATE users
+		SET max_open_reviews = $2,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+userReturning,
+		userID, limit,
+	))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return User{}, ErrUserNotFound
@@ -117,300 +381,486 @@ func (r *Repository) UpdateUserActive(ctx context.Context, userID string, active
 	return u, nil
 }
 
-func (r *Repository) CreatePullRequest(ctx context.Context, id, name, authorID string) (PullRequest, error) {
+// CreatePullRequest stores an OPEN pull request and assigns reviewers from the
+// author's team. A zero reviewersCount means the team's max_reviewers. A draft
+// is stored as DRAFT without reviewers; they are assigned when it is marked
+// ready with MarkPullRequestReady.
+func (r *Repository) CreatePullRequest(ctx context.Context, id, name, authorID string, reviewersCount int, draft bool) (PullRequest, AssignmentSummary, error) {
 	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return PullRequest{}, err
+		return PullRequest{}, AssignmentSummary{}, err
 	}
 	defer tx.Rollback(ctx)
 
-	var (
-		teamID   int64
-		teamName string
-	)
-	err = tx.QueryRow(ctx, `
-		SELECT t.id, t.name
-		FROM users u
-		JOIN teams t ON t.id = u.team_id
-		WHERE u.id = $1`,
-		authorID,
-	).Scan(&teamID, &teamName)
+	var authorTeamID *int64
+	err = tx.QueryRow(ctx, `SELECT team_id FROM users WHERE id = $1`, authorID).Scan(&authorTeamID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return PullRequest{}, ErrUserNotFound
+			return PullRequest{}, AssignmentSummary{}, ErrUserNotFound
 		}
-		return PullRequest{}, err
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+	if authorTeamID == nil {
+		return PullRequest{}, AssignmentSummary{}, ErrAuthorWithoutTeam
+	}
+	teamID := *authorTeamID
+	if err := requireActiveTeam(ctx, tx, teamID); err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+
+	settings, err := r.loadTeamSettings(ctx, tx, teamID)
+	if err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+	count, err := resolveReviewersCount(reviewersCount, settings)
+	if err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
 	}
 
+	// A draft remembers an explicitly requested count so that it can be
+	// checked again against the team settings in force when it is ready.
+	var requestedCount *int
+	if reviewersCount != 0 {
+		requestedCount = &reviewersCount
+	}
+	status := StatusOpen
+	if draft {
+		status = StatusDraft
+	}
 	if _, err := tx.Exec(ctx, `
-		INSERT INTO pull_requests (id, name, author_id, team_id, status)
-		VALUES ($1, $2, $3, $4, 'OPEN')`,
-		id, name, authorID, teamID,
+		INSERT INTO pull_requests (id, name, author_id, team_id, status, reviewers_count, drafted_at, opened_at)
+		VALUES ($1, $2, $3, $4, $5::text, $6,
+		        CASE WHEN $5::text = 'DRAFT' THEN NOW() END,
+		        CASE WHEN $5::text = 'OPEN' THEN NOW() END)`,
+		id, name, authorID, teamID, status, requestedCount,
 	); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return PullRequest{}, ErrPullRequestExists
+			return PullRequest{}, AssignmentSummary{}, ErrPullRequestExists
 		}
-		return PullRequest{}, err
+		return PullRequest{}, AssignmentSummary{}, err
 	}
 
-	reviewerRows, err := tx.Query(ctx, `
-		SELECT u.id
-		FROM users u
-		WHERE u.team_id = $1
-		  AND u.is_active
-		  AND u.id <> $2
-		ORDER BY random()
-		LIMIT 2`,
-		teamID, authorID,
-	)
+	summary := AssignmentSummary{MinReviewers: settings.MinReviewers}
+	if !draft {
+		target := PullRequest{ID: id, Name: name, AuthorID: authorID, TeamID: teamID}
+		assigned, err := r.assignReviewers(ctx, tx, target, settings, count, "pull request created")
+		if err != nil {
+			return PullRequest{}, AssignmentSummary{}, err
+		}
+		summary.Understaffed = assigned < settings.MinReviewers
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+
+	pr, err := r.GetPullRequest(ctx, id)
+	return pr, summary, err
+}
+
+// MarkPullRequestReady opens a draft pull request and assigns its reviewers
+// from the team roster and settings in force at this moment. It fails with
+// ErrPullRequestStateChanged when the pull request stopped being a draft before
+// it was locked.
+func (r *Repository) MarkPullRequestReady(ctx context.Context, id string) (PullRequest, AssignmentSummary, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return PullRequest{}, err
+		return PullRequest{}, AssignmentSummary{}, err
 	}
-	defer reviewerRows.Close()
+	defer tx.Rollback(ctx)
 
-	var reviewers []string
-	for reviewerRows.Next() {
-		var reviewerID string
-		if err := reviewerRows.Scan(&reviewerID); err != nil {
-			return PullRequest{}, err
-		}
-		reviewers = append(reviewers, reviewerID)
+	pr, err := lockPullRequest(ctx, tx, id)
+	if err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
 	}
-	if err := reviewerRows.Err(); err != nil {
-		return PullRequest{}, err
+	if pr.Status != StatusDraft {
+		return PullRequest{}, AssignmentSummary{}, ErrPullRequestStateChanged
+	}
+
+	var requestedCount int
+	if err := tx.QueryRow(ctx, `
+		SELECT COALESCE(reviewers_count, 0)
+		FROM pull_requests
+		WHERE id = $1`,
+		id,
+	).Scan(&requestedCount); err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+	settings, err := r.loadTeamSettings(ctx, tx, pr.TeamID)
+	if err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+	count, err := resolveReviewersCount(requestedCount, settings)
+	if err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+
+	assigned, err := r.assignReviewers(ctx, tx, pr, settings, count, "pull request ready for review")
+	if err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+	if _, err := tx.Exec(ctx, `
+		UPDATE pull_requests
+		SET status = 'OPEN',
+		    opened_at = NOW()
+		WHERE id = $1`,
+		id,
+	); err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PullRequest{}, AssignmentSummary{}, err
+	}
+
+	summary := AssignmentSummary{
+		MinReviewers: settings.MinReviewers,
+		Understaffed: assigned < settings.MinReviewers,
+	}
+	pr, err = r.GetPullRequest(ctx, id)
+	return pr, summary, err
+}
+
+// resolveReviewersCount applies the team default to an unspecified (zero)
+// reviewers count and checks it against the team limits. A count below the
+// required approvals is refused too, since such a pull request could never
+// satisfy the merge policy.
+func resolveReviewersCount(requested int, settings TeamSettings) (int, error) {
+	if requested == 0 {
+		requested = settings.MaxReviewers
+	}
+	if requested < settings.MinReviewers || requested > settings.MaxReviewers || requested < settings.RequiredApprovals {
+		return 0, ErrInvalidReviewersCount
 	}
+	return requested, nil
+}
 
-	for _, reviewerID := range reviewers {
+// assignReviewers selects up to count reviewers for the pull request, stores
+// them and records their assignment. It returns how many were assigned.
+func (r *Repository) assignReviewers(ctx context.Context, tx pgx.Tx, pr PullRequest, settings TeamSettings, count int, reason string) (int, error) {
+	reviewers, err := r.selectReviewers(ctx, tx, pr, settings, []string{pr.AuthorID}, count)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, reviewer := range reviewers {
 		if _, err := tx.Exec(ctx, `
-			INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-			VALUES ($1, $2)`,
-			id, reviewerID,
+			INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, fallback_team_id)
+			VALUES ($1, $2, $3)`,
+			pr.ID, reviewer.UserID, reviewer.FallbackTeamID,
 		); err != nil {
-			return PullRequest{}, err
+			return 0, err
+		}
+		if err := recordEvent(ctx, tx, AssignmentEvent{
+			PullRequestID: pr.ID,
+			Type:          EventAssigned,
+			ReviewerID:    reviewer.UserID,
+			Actor:         ActorSystem,
+			Reason:        reason,
+		}); err != nil {
+			return 0, err
 		}
 	}
+	return len(reviewers), nil
+}
 
-	if err := tx.Commit(ctx); err != nil {
+// UpdatePullRequestMerged moves the pull request from the given status to
+// MERGED if it satisfies its team's merge policy. With force set, a violating
+// pull request is merged anyway, provided the audit carries a reason; the
+// override is recorded. Merging an already MERGED pull request is a no-op.
+func (r *Repository) UpdatePullRequestMerged(ctx context.Context, id, from string, force bool, audit Audit) (PullRequest, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
 		return PullRequest{}, err
 	}
+	defer tx.Rollback(ctx)
 
-	return r.GetPullRequest(ctx, id)
-}
-
-func (r *Repository) UpdatePullRequestMerged(ctx context.Context, id string) (PullRequest, error) {
-	_, err := r.pool.Exec(ctx, `
-		UPDATE pull_requests
-		SET status = 'MERGED',
-		    merged_at = COALESCE(merged_at, NOW())
-		WHERE id = $1`,
-		id,
-	)
+	pr, err := lockPullRequest(ctx, tx, id)
 	if err != nil {
 		return PullRequest{}, err
 	}
 
-	pr, err := r.GetPullRequest(ctx, id)
-	if errors.Is(err, ErrPullRequestNotFound) {
-		return PullRequest{}, ErrPullRequestNotFound
+	if pr.Status != StatusMerged {
+		if pr.Status != from {
+			return PullRequest{}, ErrPullRequestStateChanged
+		}
+		settings, err := r.loadTeamSettings(ctx, tx, pr.TeamID)
+		if err != nil {
+			return PullRequest{}, err
+		}
+		violation, err := mergePolicyViolation(ctx, tx, pr, settings)
+		if err != nil {
+			return PullRequest{}, err
+		}
+		if violation != "" {
+			if !force {
+				return PullRequest{}, fmt.Errorf("%w: %s", ErrPolicyViolation, violation)
+			}
+			if strings.TrimSpace(audit.Reason) == "" {
+				return PullRequest{}, ErrForceReasonRequired
+			}
+			if err := recordMergeOverride(ctx, tx, id, violation, audit); err != nil {
+				return PullRequest{}, err
+			}
+		}
+
+		if _, err := tx.Exec(ctx, `
+			UPDATE pull_requests
+			SET status = 'MERGED',
+			    merged_at = NOW()
+			WHERE id = $1`,
+			id,
+		); err != nil {
+			return PullRequest{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PullRequest{}, err
 	}
-	return pr, err
+	return r.GetPullRequest(ctx, id)
 }
 
-func (r *Repository) ReassignReviewer(ctx context.Context, pullRequestID, oldReviewerID string) (PullRequest, string, error) {
+// ReassignReviewer replaces oldReviewerID on the pull request. A non-empty
+// newReviewerID names the replacement explicitly; otherwise one is chosen by
+// the team's assignment rules. The change is recorded in the assignment
+// history with the given audit details.
+func (r *Repository) ReassignReviewer(ctx context.Context, pullRequestID, oldReviewerID, newReviewerID string, audit Audit) (PullRequest, Reassignment, error) {
 	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return PullRequest{}, "", err
+		return PullRequest{}, Reassignment{}, err
 	}
 	defer tx.Rollback(ctx)
 
-	var pr PullRequest
-	err = tx.QueryRow(ctx, `
-		SELECT pr.id, pr.name, pr.author_id, pr.team_id, t.name, pr.status, pr.created_at, pr.merged_at
-		FROM pull_requests pr
-		JOIN teams t ON t.id = pr.team_id
-		WHERE pr.id = $1`,
-		pullRequestID,
-	).Scan(
-		&pr.ID,
-		&pr.Name,
-		&pr.AuthorID,
-		&pr.TeamID,
-		&pr.TeamName,
-		&pr.Status,
-		&pr.CreatedAt,
-		&pr.MergedAt,
-	)
+	pr, err := lockPullRequest(ctx, tx, pullRequestID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return PullRequest{}, "", ErrPullRequestNotFound
-		}
-		return PullRequest{}, "", err
+		return PullRequest{}, Reassignment{}, err
 	}
 
-	if pr.Status == "MERGED" {
-		return PullRequest{}, "", ErrPullRequestMerged
+	if err := requireOpen(pr.Status); err != nil {
+		return PullRequest{}, Reassignment{}, err
 	}
 
-	var assignedCount int
-	err = tx.QueryRow(ctx, `
-		SELECT COUNT(*) FROM pull_request_reviewers WHERE pull_request_id = $1 AND reviewer_id = $2`,
-		pullRequestID, oldReviewerID,
-	).Scan(&assignedCount)
+	reassignment, err := r.replaceReviewer(ctx, tx, pr, oldReviewerID, newReviewerID, audit)
 	if err != nil {
-		return PullRequest{}, "", err
+		return PullRequest{}, Reassignment{}, err
 	}
-	if assignedCount == 0 {
-		return PullRequest{}, "", ErrReviewerNotAssigned
+
+	if err := tx.Commit(ctx); err != nil {
+		return PullRequest{}, Reassignment{}, err
 	}
 
+	updatedPR, err := r.GetPullRequest(ctx, pullRequestID)
+	return updatedPR, reassignment, err
+}
+
+// replaceReviewer swaps oldReviewerID on the pull request for requested, or
+// for a reviewer chosen by the team's assignment rules when requested is empty,
+// and records the change. Chosen reviewers are never ones previously removed
+// from the pull request unless nobody else is available.
+func (r *Repository) replaceReviewer(ctx context.Context, tx pgx.Tx, pr PullRequest, oldReviewerID, requested string, audit Audit) (Reassignment, error) {
 	reviewerRows, err := tx.Query(ctx, `
 		SELECT reviewer_id
 		FROM pull_request_reviewers
 		WHERE pull_request_id = $1`,
-		pullRequestID,
+		pr.ID,
 	)
 	if err != nil {
-		return PullRequest{}, "", err
+		return Reassignment{}, err
 	}
 	defer reviewerRows.Close()
 
-	currentReviewers := map[string]struct{}{}
+	assigned := false
+	exclude := []string{pr.AuthorID}
 	for reviewerRows.Next() {
 		var reviewerID string
 		if err := reviewerRows.Scan(&reviewerID); err != nil {
-			return PullRequest{}, "", err
+			return Reassignment{}, err
+		}
+		if reviewerID == oldReviewerID {
+			assigned = true
 		}
-		currentReviewers[reviewerID] = struct{}{}
+		exclude = append(exclude, reviewerID)
 	}
 	if err := reviewerRows.Err(); err != nil {
-		return PullRequest{}, "", err
+		return Reassignment{}, err
 	}
-
-	candidateRows, err := tx.Query(ctx, `
-		SELECT u.id
-		FROM users u
-		WHERE u.team_id = $1
-		  AND u.is_active
-		  AND u.id <> $2`,
-		pr.TeamID, pr.AuthorID,
-	)
-	if err != nil {
-		return PullRequest{}, "", err
+	if !assigned {
+		return Reassignment{}, ErrReviewerNotAssigned
 	}
-	defer candidateRows.Close()
 
-	var candidates []string
-	for candidateRows.Next() {
-		var candidateID string
-		if err := candidateRows.Scan(&candidateID); err != nil {
-			return PullRequest{}, "", err
+	reassignment := Reassignment{PullRequestID: pr.ID}
+	var newReviewer pick
+	if requested != "" {
+		if err := checkRequestedReviewer(ctx, tx, pr, requested, exclude); err != nil {
+			return Reassignment{}, err
 		}
-		if _, already := currentReviewers[candidateID]; already {
-			continue
+		newReviewer = pick{UserID: requested}
+	} else {
+		settings, err := r.loadTeamSettings(ctx, tx, pr.TeamID)
+		if err != nil {
+			return Reassignment{}, err
+		}
+		removed, err := removedReviewers(ctx, tx, pr.ID)
+		if err != nil {
+			return Reassignment{}, err
 		}
-		candidates = append(candidates, candidateID)
-	}
-	if err := candidateRows.Err(); err != nil {
-		return PullRequest{}, "", err
-	}
 
-	if len(candidates) == 0 {
-		return PullRequest{}, "", ErrNoReviewerCandidate
+		candidates, err := r.selectReviewers(ctx, tx, pr, settings, append(exclude, removed...), 1)
+		if err != nil && !errors.Is(err, ErrCapacityExceeded) {
+			return Reassignment{}, err
+		}
+		if len(candidates) == 0 && len(removed) > 0 {
+			reassignment.ExclusionRelaxed = true
+			candidates, err = r.selectReviewers(ctx, tx, pr, settings, exclude, 1)
+		}
+		if err != nil {
+			return Reassignment{}, err
+		}
+		if len(candidates) == 0 {
+			return Reassignment{}, ErrNoReviewerCandidate
+		}
+		newReviewer = candidates[0]
 	}
 
-	newReviewer := candidates[r.rng.Intn(len(candidates))]
 	_, err = tx.Exec(ctx, `
 		UPDATE pull_request_reviewers
 		SET reviewer_id = $3,
-		    assigned_at = NOW()
+		    fallback_team_id = $4,
+		    assigned_at = NOW(),
+		    verdict = NULL,
+		    verdict_at = NULL
 		WHERE pull_request_id = $1 AND reviewer_id = $2`,
-		pullRequestID, oldReviewerID, newReviewer,
+		pr.ID, oldReviewerID, newReviewer.UserID, newReviewer.FallbackTeamID,
 	)
 	if err != nil {
-		return PullRequest{}, "", err
+		return Reassignment{}, err
+	}
+	err = recordEvent(ctx, tx, AssignmentEvent{
+		PullRequestID:      pr.ID,
+		Type:               EventReassigned,
+		ReviewerID:         newReviewer.UserID,
+		PreviousReviewerID: &oldReviewerID,
+		Actor:              audit.Actor,
+		Reason:             audit.Reason,
+	})
+	if err != nil {
+		return Reassignment{}, err
 	}
+	reassignment.ReplacedBy = newReviewer.UserID
+	return reassignment, nil
+}
 
-	if err := tx.Commit(ctx); err != nil {
-		return PullRequest{}, "", err
+// checkRequestedReviewer verifies that an explicitly requested reviewer is an
+// active member of the pull request's team who is neither its author nor one
+// of its current reviewers.
+func checkRequestedReviewer(ctx context.Context, tx pgx.Tx, pr PullRequest, userID string, assigned []string) error {
+	var (
+		teamID   *int64
+		isActive bool
+	)
+	err := tx.QueryRow(ctx, `SELECT team_id, is_active FROM users WHERE id = $1`, userID).Scan(&teamID, &isActive)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return err
 	}
 
-	updatedPR, err := r.GetPullRequest(ctx, pullRequestID)
-	return updatedPR, newReviewer, err
+	switch {
+	case userID == pr.AuthorID:
+		return ErrReviewerIsAuthor
+	case slices.Contains(assigned, userID):
+		return ErrReviewerAlreadyAssigned
+	case teamID == nil || *teamID != pr.TeamID:
+		return ErrReviewerNotInTeam
+	case !isActive:
+		return ErrReviewerInactive
+	}
+	return nil
 }
 
-func (r *Repository) GetPullRequest(ctx context.Context, id string) (PullRequest, error) {
-	var pr PullRequest
-	err := r.pool.QueryRow(ctx, `
-		SELECT pr.id, pr.name, pr.author_id, pr.team_id, t.name, pr.status, pr.created_at, pr.merged_at
+// lockPullRequest loads the pull request and locks its row until the
+// transaction ends.
+func lockPullRequest(ctx context.Context, tx pgx.Tx, id string) (PullRequest, error) {
+	return scanPullRequest(tx.QueryRow(ctx, `
+		SELECT `+pullRequestColumns+`
 		FROM pull_requests pr
 		JOIN teams t ON t.id = pr.team_id
-		WHERE pr.id = $1`,
+		WHERE pr.id = $1
+		FOR UPDATE OF pr`,
 		id,
-	).Scan(
-		&pr.ID,
-		&pr.Name,
-		&pr.AuthorID,
-		&pr.TeamID,
-		&pr.TeamName,
-		&pr.Status,
-		&pr.CreatedAt,
-		&pr.MergedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return PullRequest{}, ErrPullRequestNotFound
-		}
-		return PullRequest{}, err
-	}
+	))
+}
 
-	reviewerRows, err := r.pool.Query(ctx, `
-		SELECT reviewer_id
-		FROM pull_request_reviewers
-		WHERE pull_request_id = $1
-		ORDER BY assigned_at`,
+func (r *Repository) GetPullRequest(ctx context.Context, id string) (PullRequest, error) {
+	pr, err := scanPullRequest(r.pool.QueryRow(ctx, `
+		SELECT `+pullRequestColumns+`
+		FROM pull_requests pr
+		JOIN teams t ON t.id = pr.team_id
+		WHERE pr.id = $1`,
 		id,
-	)
+	))
 	if err != nil {
 		return PullRequest{}, err
 	}
-	defer reviewerRows.Close()
 
-	for reviewerRows.Next() {
-		var reviewerID string
-		if err := reviewerRows.Scan(&reviewerID); err != nil {
-			return PullRequest{}, err
-		}
-		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
-	}
-	if err := reviewerRows.Err(); err != nil {
+	prs := []PullRequest{pr}
+	if err := loadReviewers(ctx, r.pool, prs); err != nil {
 		return PullRequest{}, err
 	}
-
-	return pr, nil
+	return prs[0], nil
 }
 
-func (r *Repository) ListPullRequestsByReviewer(ctx context.Context, reviewerID string) ([]PullRequestShort, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at
-		FROM pull_requests pr
-		JOIN pull_request_reviewers rvr ON rvr.pull_request_id = pr.id
-		WHERE rvr.reviewer_id = $1
-		ORDER BY pr.created_at DESC`,
-		reviewerID,
+// loadReviewers fills in the assigned reviewers, their verdicts and fallback
+// teams of the given pull requests.
+func loadReviewers(ctx context.Context, q querier, prs []PullRequest) error {
+	if len(prs) == 0 {
+		return nil
+	}
+	index := make(map[string]int, len(prs))
+	ids := make([]string, 0, len(prs))
+	for i, pr := range prs {
+		index[pr.ID] = i
+		ids = append(ids, pr.ID)
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT rvr.pull_request_id, rvr.reviewer_id, rvr.fallback_team_id, ft.name, rvr.verdict, rvr.verdict_at
+		FROM pull_request_reviewers rvr
+		LEFT JOIN teams ft ON ft.id = rvr.fallback_team_id
+		WHERE rvr.pull_request_id = ANY($1)
+		ORDER BY rvr.assigned_at`,
+		ids,
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer rows.Close()
 
-	var prs []PullRequestShort
 	for rows.Next() {
-		var pr PullRequestShort
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt); err != nil {
-			return nil, err
+		var (
+			prID           string
+			reviewerID     string
+			fallbackTeamID *int64
+			fallbackTeam   *string
+			review         Review
+		)
+		if err := rows.Scan(&prID, &reviewerID, &fallbackTeamID, &fallbackTeam, &review.Verdict, &review.SubmittedAt); err != nil {
+			return err
+		}
+		pr := &prs[index[prID]]
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+		review.ReviewerID = reviewerID
+		pr.Reviews = append(pr.Reviews, review)
+		if fallbackTeamID != nil && fallbackTeam != nil {
+			pr.FallbackReviewers = append(pr.FallbackReviewers, FallbackReviewer{
+				UserID:   reviewerID,
+				TeamID:   *fallbackTeamID,
+				TeamName: *fallbackTeam,
+			})
 		}
-		prs = append(prs, pr)
 	}
-	return prs, rows.Err()
+	return rows.Err()
 }
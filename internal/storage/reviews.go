@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Review verdicts a reviewer can submit.
+const (
+	VerdictApproved         = "APPROVED"
+	VerdictChangesRequested = "CHANGES_REQUESTED"
+	VerdictCommented        = "COMMENTED"
+)
+
+// SubmitReview stores the reviewer's verdict on the pull request. A reviewer
+// may submit again; the latest verdict replaces the previous one.
+func (r *Repository) SubmitReview(ctx context.Context, pullRequestID, reviewerID, verdict string) (PullRequest, error) {
+	switch verdict {
+	case VerdictApproved, VerdictChangesRequested, VerdictCommented:
+	default:
+		return PullRequest{}, ErrInvalidVerdict
+	}
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return PullRequest{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	pr, err := lockPullRequest(ctx, tx, pullRequestID)
+	if err != nil {
+		return PullRequest{}, err
+	}
+	if err := requireOpen(pr.Status); err != nil {
+		return PullRequest{}, err
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE pull_request_reviewers
+		SET verdict = $3,
+		    verdict_at = NOW()
+		WHERE pull_request_id = $1 AND reviewer_id = $2`,
+		pullRequestID, reviewerID, verdict,
+	)
+	if err != nil {
+		return PullRequest{}, err
+	}
+	if tag.RowsAffected() == 0 {
+		return PullRequest{}, ErrReviewerNotAssigned
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PullRequest{}, err
+	}
+	return r.GetPullRequest(ctx, pullRequestID)
+}
@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// requireActiveTeam locks the team against archiving for the rest of the
+// transaction and returns ErrTeamArchived when it is already archived. The
+// lock is shared, so it suits transactions that only read the team row;
+// those that go on to update it use lockActiveTeamID, since two shared holders
+// upgrading their locks would deadlock.
+func requireActiveTeam(ctx context.Context, q querier, teamID int64) error {
+	return checkActiveTeam(ctx, q, teamID, `FOR SHARE`)
+}
+
+func checkActiveTeam(ctx context.Context, q querier, teamID int64, lock string) error {
+	var archivedAt *time.Time
+	if err := q.QueryRow(ctx, `SELECT archived_at FROM teams WHERE id = $1 `+lock, teamID).Scan(&archivedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTeamNotFound
+		}
+		return err
+	}
+	if archivedAt != nil {
+		return ErrTeamArchived
+	}
+	return nil
+}
+
+// lookupActiveTeamID resolves a team that may still be changed.
+func lookupActiveTeamID(ctx context.Context, q querier, name string) (int64, error) {
+	teamID, err := lookupTeamID(ctx, q, name)
+	if err != nil {
+		return 0, err
+	}
+	return teamID, requireActiveTeam(ctx, q, teamID)
+}
+
+// lockActiveTeamID is lookupActiveTeamID for transactions that update the team
+// row; it locks the row exclusively up front.
+func lockActiveTeamID(ctx context.Context, q querier, name string) (int64, error) {
+	teamID, err := lookupTeamID(ctx, q, name)
+	if err != nil {
+		return 0, err
+	}
+	return teamID, checkActiveTeam(ctx, q, teamID, `FOR NO KEY UPDATE`)
+}
+
+// ArchiveTeam makes the team read-only: its settings and members can no longer
+// be changed, except for members leaving it, and its members cannot open new
+// pull requests. A team with DRAFT or OPEN pull requests is refused with
+// ErrTeamHasActivePullRequests. Archiving an archived team changes nothing.
+func (r *Repository) ArchiveTeam(ctx context.Context, name string) (Team, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Team{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	teamID, err := lookupTeamID(ctx, tx, name)
+	if err != nil {
+		return Team{}, err
+	}
+	var archivedAt *time.Time
+	if err := tx.QueryRow(ctx, `SELECT archived_at FROM teams WHERE id = $1 FOR UPDATE`, teamID).Scan(&archivedAt); err != nil {
+		return Team{}, err
+	}
+	if archivedAt != nil {
+		return r.GetTeam(ctx, name)
+	}
+
+	active, err := collectIDs(ctx, tx, `
+		SELECT id
+		FROM pull_requests
+		WHERE team_id = $1
+		  AND status IN ('DRAFT', 'OPEN')
+		ORDER BY id`,
+		teamID,
+	)
+	if err != nil {
+		return Team{}, err
+	}
+	if len(active) > 0 {
+		return Team{}, fmt.Errorf("%w: %s", ErrTeamHasActivePullRequests, strings.Join(active, ", "))
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE teams SET archived_at = NOW() WHERE id = $1`, teamID); err != nil {
+		return Team{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Team{}, err
+	}
+	return r.GetTeam(ctx, name)
+}
+
+// DeleteTeam removes a team that has neither members, sub-teams nor pull
+// requests and returns it as it was. Otherwise it is refused with
+// ErrTeamNotEmpty listing the members to move out, the sub-teams to move
+// elsewhere and the number of pull requests that keep the team in the history;
+// such a team can only be archived.
+func (r *Repository) DeleteTeam(ctx context.Context, name string) (Team, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Team{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	teamID, err := lookupTeamID(ctx, tx, name)
+	if err != nil {
+		return Team{}, err
+	}
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM teams WHERE id = $1 FOR UPDATE`, teamID); err != nil {
+		return Team{}, err
+	}
+
+	members, err := collectIDs(ctx, tx, `SELECT id FROM users WHERE team_id = $1 ORDER BY id`, teamID)
+	if err != nil {
+		return Team{}, err
+	}
+	subTeams, err := collectIDs(ctx, tx, `SELECT name FROM teams WHERE parent_id = $1 ORDER BY name`, teamID)
+	if err != nil {
+		return Team{}, err
+	}
+	var pullRequests int
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM pull_requests WHERE team_id = $1`, teamID).Scan(&pullRequests); err != nil {
+		return Team{}, err
+	}
+
+	var blockers []string
+	if len(members) > 0 {
+		blockers = append(blockers, "members "+strings.Join(members, ", "))
+	}
+	if len(subTeams) > 0 {
+		blockers = append(blockers, "sub-teams "+strings.Join(subTeams, ", "))
+	}
+	if pullRequests > 0 {
+		blockers = append(blockers, fmt.Sprintf("%d pull requests", pullRequests))
+	}
+	if len(blockers) > 0 {
+		return Team{}, fmt.Errorf("%w: %s", ErrTeamNotEmpty, strings.Join(blockers, "; "))
+	}
+
+	team, err := r.GetTeam(ctx, name)
+	if err != nil {
+		return Team{}, err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM teams WHERE id = $1`, teamID); err != nil {
+		return Team{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Team{}, err
+	}
+	return team, nil
+}
+
+// RenameTeam gives the team a new name, keeping its id. With a positive grace
+// period the old name stays an alias of the team until the period ends. A new
+// name taken by another team or by an alias of one is refused with
+// ErrTeamExists; renaming a team back to one of its own aliases drops the
+// alias.
+func (r *Repository) RenameTeam(ctx context.Context, name, newName string, grace time.Duration) (Team, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Team{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	teamID, err := lookupTeamID(ctx, tx, name)
+	if err != nil {
+		return Team{}, err
+	}
+	var current string
+	if err := tx.QueryRow(ctx, `SELECT name FROM teams WHERE id = $1 FOR UPDATE`, teamID).Scan(&current); err != nil {
+		return Team{}, err
+	}
+	if newName == current {
+		return r.GetTeam(ctx, current)
+	}
+
+	owner, err := lookupTeamID(ctx, tx, newName)
+	switch {
+	case errors.Is(err, ErrTeamNotFound):
+	case err != nil:
+		return Team{}, err
+	case owner != teamID:
+		return Team{}, ErrTeamExists
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM team_aliases WHERE name = $1`, newName); err != nil {
+		return Team{}, err
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE teams SET name = $2 WHERE id = $1`, teamID, newName); err != nil {
+		return Team{}, err
+	}
+	if grace > 0 {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO team_aliases (name, team_id, expires_at)
+			VALUES ($1, $2, NOW() + make_interval(secs => $3))
+			ON CONFLICT (name) DO UPDATE
+			SET team_id = EXCLUDED.team_id,
+			    expires_at = EXCLUDED.expires_at,
+			    created_at = NOW()`,
+			current, teamID, grace.Seconds(),
+		); err != nil {
+			return Team{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Team{}, err
+	}
+	return r.GetTeam(ctx, newName)
+}
+
+// collectIDs runs a query returning a single text column.
+func collectIDs(ctx context.Context, q querier, query string, args ...any) ([]string, error) {
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
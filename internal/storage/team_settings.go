@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avito/pr-reviewer-assignment-service/internal/assignment"
+)
+
+const (
+	DefaultMinReviewers = 1
+	DefaultMaxReviewers = 2
+	MaxReviewersLimit   = 10
+)
+
+// Capacity overflow policies decide what happens to a reviewer slot when every
+// remaining candidate already holds their maximum number of open reviews.
+const (
+	OverflowAssign     = "assign"
+	OverflowLeaveEmpty = "leave_empty"
+	OverflowReject     = "reject"
+
+	DefaultCapacityOverflow = OverflowLeaveEmpty
+)
+
+func defaultTeamSettings() TeamSettings {
+	return TeamSettings{
+		AssignmentStrategy: assignment.DefaultStrategy,
+		MinReviewers:       DefaultMinReviewers,
+		MaxReviewers:       DefaultMaxReviewers,
+		CapacityOverflow:   DefaultCapacityOverflow,
+	}
+}
+
+func (p TeamSettingsPatch) applyTo(s *TeamSettings) {
+	if p.AssignmentStrategy != nil {
+		s.AssignmentStrategy = *p.AssignmentStrategy
+	}
+	if p.MinReviewers != nil {
+		s.MinReviewers = *p.MinReviewers
+	}
+	if p.MaxReviewers != nil {
+		s.MaxReviewers = *p.MaxReviewers
+	}
+	if p.MaxOpenReviews != nil {
+		s.MaxOpenReviews = *p.MaxOpenReviews
+	}
+	if p.CapacityOverflow != nil {
+		s.CapacityOverflow = *p.CapacityOverflow
+	}
+	if p.PreferWorkingHours != nil {
+		s.PreferWorkingHours = *p.PreferWorkingHours
+	}
+	if p.FallbackTeams != nil {
+		s.FallbackTeams = *p.FallbackTeams
+	}
+	if p.RequiredApprovals != nil {
+		s.RequiredApprovals = *p.RequiredApprovals
+	}
+	if p.BlockOnChangesRequested != nil {
+		s.BlockOnChangesRequested = *p.BlockOnChangesRequested
+	}
+}
+
+func (r *Repository) validateSettings(s TeamSettings) error {
+	if !r.strategies.Has(s.AssignmentStrategy) {
+		return ErrUnknownStrategy
+	}
+	if s.MinReviewers < 0 || s.MaxReviewers < 1 || s.MaxReviewers > MaxReviewersLimit || s.MinReviewers > s.MaxReviewers {
+		return ErrInvalidReviewerLimits
+	}
+	if s.MaxOpenReviews < 0 {
+		return ErrInvalidCapacity
+	}
+	switch s.CapacityOverflow {
+	case OverflowAssign, OverflowLeaveEmpty, OverflowReject:
+	default:
+		return ErrInvalidOverflowPolicy
+	}
+	if s.RequiredApprovals < 0 || s.RequiredApprovals > s.MaxReviewers {
+		return ErrInvalidMergePolicy
+	}
+	seen := make(map[string]bool, len(s.FallbackTeams))
+	for _, name := range s.FallbackTeams {
+		if name == "" || seen[name] {
+			return ErrInvalidFallbackTeams
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// loadTeamSettings returns the effective settings of the team: the stored
+// settings of its ancestors are applied from the top of the tree down, so the
+// nearest team that sets a value wins.
+func (r *Repository) loadTeamSettings(ctx context.Context, q querier, teamID int64) (TeamSettings, error) {
+	rows, err := q.Query(ctx, `
+		WITH RECURSIVE chain AS (
+			SELECT id, parent_id, 0 AS depth
+			FROM teams
+			WHERE id = $1
+			UNION ALL
+			SELECT t.id, t.parent_id, c.depth + 1
+			FROM teams t
+			JOIN chain c ON t.id = c.parent_id
+		)
+		SELECT t.assignment_strategy, t.min_reviewers, t.max_reviewers, t.max_open_reviews, t.capacity_overflow,
+		       t.prefer_working_hours, t.required_approvals, t.block_on_changes_requested
+		FROM chain c
+		JOIN teams t ON t.id = c.id
+		ORDER BY c.depth DESC`,
+		teamID,
+	)
+	if err != nil {
+		return TeamSettings{}, err
+	}
+	defer rows.Close()
+
+	settings := defaultTeamSettings()
+	found := false
+	for rows.Next() {
+		var patch TeamSettingsPatch
+		if err := rows.Scan(
+			&patch.AssignmentStrategy,
+			&patch.MinReviewers,
+			&patch.MaxReviewers,
+			&patch.MaxOpenReviews,
+			&patch.CapacityOverflow,
+			&patch.PreferWorkingHours,
+			&patch.RequiredApprovals,
+			&patch.BlockOnChangesRequested,
+		); err != nil {
+			return TeamSettings{}, err
+		}
+		patch.applyTo(&settings)
+		found = true
+	}
+	if err := rows.Err(); err != nil {
+		return TeamSettings{}, err
+	}
+	if !found {
+		return TeamSettings{}, ErrTeamNotFound
+	}
+
+	if settings.FallbackTeams, err = loadFallbackTeams(ctx, q, teamID); err != nil {
+		return TeamSettings{}, err
+	}
+	if !r.strategies.Has(settings.AssignmentStrategy) {
+		settings.AssignmentStrategy = assignment.DefaultStrategy
+	}
+	return settings, nil
+}
+
+// inheritedSettings returns the settings a new sub-team of parentID starts
+// with; a nil parentID gives the defaults.
+func (r *Repository) inheritedSettings(ctx context.Context, q querier, parentID *int64) (TeamSettings, error) {
+	if parentID == nil {
+		return defaultTeamSettings(), nil
+	}
+	settings, err := r.loadTeamSettings(ctx, q, *parentID)
+	if err != nil {
+		return TeamSettings{}, err
+	}
+	settings.FallbackTeams = nil
+	return settings, nil
+}
+
+// validateSubTeamSettings checks the effective settings of every sub-team below
+// the team after a change the sub-teams inherit. An invalid combination is
+// reported as ErrInvalidSubTeamSettings naming the sub-team.
+func (r *Repository) validateSubTeamSettings(ctx context.Context, q querier, teamID int64) error {
+	rows, err := q.Query(ctx, `
+		WITH RECURSIVE sub AS (
+			SELECT id, name
+			FROM teams
+			WHERE parent_id = $1
+			UNION ALL
+			SELECT t.id, t.name
+			FROM teams t
+			JOIN sub s ON t.parent_id = s.id
+		)
+		SELECT id, name
+		FROM sub
+		ORDER BY name`,
+		teamID,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type subTeam struct {
+		id   int64
+		name string
+	}
+	var subTeams []subTeam
+	for rows.Next() {
+		var st subTeam
+		if err := rows.Scan(&st.id, &st.name); err != nil {
+			return err
+		}
+		subTeams = append(subTeams, st)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, st := range subTeams {
+		settings, err := r.loadTeamSettings(ctx, q, st.id)
+		if err != nil {
+			return err
+		}
+		if err := r.validateSettings(settings); err != nil {
+			return fmt.Errorf("%w %s: %v", ErrInvalidSubTeamSettings, st.name, err)
+		}
+	}
+	return nil
+}
+
+func saveTeamSettings(ctx context.Context, q querier, teamID int64, patch TeamSettingsPatch) error {
+	_, err := q.Exec(ctx, `
+		UPDATE teams
+		SET assignment_strategy = COALESCE($2, assignment_strategy),
+		    min_reviewers = COALESCE($3, min_reviewers),
+		    max_reviewers = COALESCE($4, max_reviewers),
+		    max_open_reviews = COALESCE($5, max_open_reviews),
+		    capacity_overflow = COALESCE($6, capacity_overflow),
+		    prefer_working_hours = COALESCE($7, prefer_working_hours),
+		    required_approvals = COALESCE($8, required_approvals),
+		    block_on_changes_requested = COALESCE($9, block_on_changes_requested)
+		WHERE id = $1`,
+		teamID,
+		patch.AssignmentStrategy,
+		patch.MinReviewers,
+		patch.MaxReviewers,
+		patch.MaxOpenReviews,
+		patch.CapacityOverflow,
+		patch.PreferWorkingHours,
+		patch.RequiredApprovals,
+		patch.BlockOnChangesRequested,
+	)
+	if err != nil || patch.FallbackTeams == nil {
+		return err
+	}
+	return saveFallbackTeams(ctx, q, teamID, *patch.FallbackTeams)
+}
@@ -0,0 +1,256 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Actions of a team sync change.
+const (
+	SyncCreate     = "CREATE"
+	SyncUpdate     = "UPDATE"
+	SyncMove       = "MOVE"
+	SyncDeactivate = "DEACTIVATE"
+)
+
+// Roster is the complete desired membership of a team.
+type Roster struct {
+	TeamName string
+	Members  []TeamMember
+}
+
+// SyncChange is one change a team sync makes to a user.
+type SyncChange struct {
+	TeamID   int64
+	TeamName string
+	UserID   string
+	Action   string
+	// FromTeam is the team a moved user leaves; empty when they had none.
+	FromTeam string
+}
+
+// SyncResult lists the changes of a team sync and, unless it was a dry run,
+// what happened to the OPEN reviews of moved and deactivated users.
+type SyncResult struct {
+	Changes  []SyncChange
+	Handover ReviewHandover
+}
+
+// syncedUser is the stored state of a user touched by a team sync.
+type syncedUser struct {
+	id             string
+	username       string
+	isActive       bool
+	maxOpenReviews *int
+	teamID         *int64
+	teamName       string
+}
+
+// pendingHandover is a review handover deferred until every membership change
+// is applied, so that candidates are picked from the final team compositions.
+type pendingHandover struct {
+	userID string
+	teamID *int64
+	reason string
+}
+
+// SyncTeams brings the listed teams to exactly the given rosters: unknown
+// users are created, changed details are updated, users of other teams are
+// moved in and active members missing from every roster are deactivated.
+// A nil MaxOpenReviews keeps the user's current capacity. With dryRun the
+// changes are only planned and nothing is written.
+func (r *Repository) SyncTeams(ctx context.Context, rosters []Roster, dryRun bool) (SyncResult, error) {
+	if err := validateRosters(rosters); err != nil {
+		return SyncResult{}, err
+	}
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return SyncResult{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	teamIDs := make([]int64, len(rosters))
+	desired := make(map[string]bool)
+	var userIDs []string
+	for i, roster := range rosters {
+		if teamIDs[i], err = lookupActiveTeamID(ctx, tx, roster.TeamName); err != nil {
+			return SyncResult{}, err
+		}
+		if slices.Contains(teamIDs[:i], teamIDs[i]) {
+			return SyncResult{}, fmt.Errorf("%w: team %s listed more than once", ErrInvalidRoster, roster.TeamName)
+		}
+		for _, m := range roster.Members {
+			desired[m.ID] = true
+			userIDs = append(userIDs, m.ID)
+		}
+	}
+
+	users, err := lockSyncedUsers(ctx, tx, userIDs, teamIDs)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	current := make(map[string]syncedUser, len(users))
+	for _, u := range users {
+		current[u.id] = u
+	}
+
+	var result SyncResult
+	var handovers []pendingHandover
+	for i, roster := range rosters {
+		for _, m := range roster.Members {
+			change := SyncChange{TeamID: teamIDs[i], TeamName: roster.TeamName, UserID: m.ID}
+			u, exists := current[m.ID]
+			switch {
+			case !exists:
+				change.Action = SyncCreate
+			case u.teamID == nil || *u.teamID != teamIDs[i]:
+				change.Action = SyncMove
+				change.FromTeam = u.teamName
+			case memberChanged(u, m):
+				change.Action = SyncUpdate
+			default:
+				continue
+			}
+			result.Changes = append(result.Changes, change)
+
+			switch {
+			case exists && u.isActive && !m.IsActive:
+				handovers = append(handovers, pendingHandover{m.ID, nil, "reviewer deactivated"})
+			case change.Action == SyncMove && u.teamID != nil:
+				handovers = append(handovers, pendingHandover{m.ID, u.teamID, "reviewer moved to another team"})
+			}
+			if dryRun {
+				continue
+			}
+			if _, err := upsertMember(ctx, tx, teamIDs[i], m); err != nil {
+				return SyncResult{}, err
+			}
+			if change.Action == SyncMove {
+				if err := recordMembershipMove(ctx, tx, m.ID, u.teamID, &teamIDs[i], Audit{Reason: "team roster sync"}); err != nil {
+					return SyncResult{}, err
+				}
+			}
+		}
+
+		for _, u := range users {
+			if desired[u.id] || u.teamID == nil || *u.teamID != teamIDs[i] {
+				continue
+			}
+			if !u.isActive {
+				// Already inactive, e.g. on leave. Dropping the deactivation
+				// time keeps them from being turned back on when it ends.
+				if dryRun {
+					continue
+				}
+				if _, err := tx.Exec(ctx, `UPDATE users SET deactivated_at = NULL WHERE id = $1`, u.id); err != nil {
+					return SyncResult{}, err
+				}
+				continue
+			}
+			result.Changes = append(result.Changes, SyncChange{
+				TeamID:   teamIDs[i],
+				TeamName: roster.TeamName,
+				UserID:   u.id,
+				Action:   SyncDeactivate,
+			})
+			handovers = append(handovers, pendingHandover{u.id, nil, "reviewer deactivated"})
+			if dryRun {
+				continue
+			}
+			if _, err := tx.Exec(ctx, `
+				UPDATE users
+				SET is_active = FALSE,
+				    updated_at = NOW()
+				WHERE id = $1`,
+				u.id,
+			); err != nil {
+				return SyncResult{}, err
+			}
+		}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	for _, h := range handovers {
+		handover, err := r.handOverReviews(ctx, tx, h.userID, h.teamID, h.reason)
+		if err != nil {
+			return SyncResult{}, err
+		}
+		result.Handover.Reassigned = append(result.Handover.Reassigned, handover.Reassigned...)
+		result.Handover.WithoutCandidate = append(result.Handover.WithoutCandidate, handover.WithoutCandidate...)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return SyncResult{}, err
+	}
+	return result, nil
+}
+
+// validateRosters rejects rosters that list a team or a user more than once,
+// members without an id and invalid personal capacities.
+func validateRosters(rosters []Roster) error {
+	teams := make(map[string]bool)
+	users := make(map[string]bool)
+	for _, roster := range rosters {
+		if teams[roster.TeamName] {
+			return fmt.Errorf("%w: team %s listed more than once", ErrInvalidRoster, roster.TeamName)
+		}
+		teams[roster.TeamName] = true
+		for _, m := range roster.Members {
+			if m.ID == "" {
+				return fmt.Errorf("%w: member of team %s without user_id", ErrInvalidRoster, roster.TeamName)
+			}
+			if users[m.ID] {
+				return fmt.Errorf("%w: user %s listed more than once", ErrInvalidRoster, m.ID)
+			}
+			users[m.ID] = true
+			if m.MaxOpenReviews != nil && *m.MaxOpenReviews < 1 {
+				return ErrInvalidCapacity
+			}
+		}
+	}
+	return nil
+}
+
+// lockSyncedUsers locks and returns, ordered by id, the listed users together
+// with every current member of the listed teams.
+func lockSyncedUsers(ctx context.Context, tx pgx.Tx, userIDs []string, teamIDs []int64) ([]syncedUser, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT u.id, u.username, u.is_active, u.max_open_reviews, u.team_id, COALESCE(t.name, '')
+		FROM users u
+		LEFT JOIN teams t ON t.id = u.team_id
+		WHERE u.id = ANY($1) OR u.team_id = ANY($2)
+		ORDER BY u.id
+		FOR UPDATE OF u`,
+		userIDs, teamIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []syncedUser
+	for rows.Next() {
+		var u syncedUser
+		if err := rows.Scan(&u.id, &u.username, &u.isActive, &u.maxOpenReviews, &u.teamID, &u.teamName); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+// memberChanged reports whether applying the roster entry would change the
+// stored user.
+func memberChanged(u syncedUser, m TeamMember) bool {
+	if u.username != m.Username || u.isActive != m.IsActive {
+		return true
+	}
+	return m.MaxOpenReviews != nil && (u.maxOpenReviews == nil || *u.maxOpenReviews != *m.MaxOpenReviews)
+}
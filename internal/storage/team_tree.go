@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SetTeamParent nests the team under the parent team, or makes it a top-level
+// team when parentName is empty. Both teams must be active. A parent inside the
+// team's own subtree is refused with ErrInvalidTeamParent, and a move that
+// would leave the team or one of its sub-teams with invalid inherited settings
+// is refused as well.
+func (r *Repository) SetTeamParent(ctx context.Context, name, parentName string) (Team, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Team{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Parent changes are serialized so that two concurrent moves cannot close
+	// a cycle that neither of them sees on its own.
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('teams.parent_id'))`); err != nil {
+		return Team{}, err
+	}
+
+	teamID, err := lockActiveTeamID(ctx, tx, name)
+	if err != nil {
+		return Team{}, err
+	}
+	var parentID *int64
+	if parentName != "" {
+		id, err := lookupActiveTeamID(ctx, tx, parentName)
+		if err != nil {
+			return Team{}, err
+		}
+		var cycle bool
+		if err := tx.QueryRow(ctx, `
+			WITH RECURSIVE chain AS (
+				SELECT id, parent_id
+				FROM teams
+				WHERE id = $1
+				UNION ALL
+				SELECT t.id, t.parent_id
+				FROM teams t
+				JOIN chain c ON t.id = c.parent_id
+			)
+			SELECT EXISTS (SELECT 1 FROM chain WHERE id = $2)`,
+			id, teamID,
+		).Scan(&cycle); err != nil {
+			return Team{}, err
+		}
+		if cycle {
+			return Team{}, ErrInvalidTeamParent
+		}
+		parentID = &id
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE teams SET parent_id = $2 WHERE id = $1`, teamID, parentID); err != nil {
+		return Team{}, err
+	}
+
+	settings, err := r.loadTeamSettings(ctx, tx, teamID)
+	if err != nil {
+		return Team{}, err
+	}
+	if err := r.validateSettings(settings); err != nil {
+		return Team{}, err
+	}
+	if err := r.validateSubTeamSettings(ctx, tx, teamID); err != nil {
+		return Team{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Team{}, err
+	}
+	return r.GetTeam(ctx, name)
+}
+
+// TeamTree returns the sub-teams of the team with rootID, or the top-level
+// teams when rootID is nil, each with its own sub-teams. Teams on the same
+// level are ordered by name.
+func (r *Repository) TeamTree(ctx context.Context, rootID *int64) ([]TeamNode, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH RECURSIVE tree AS (
+			SELECT id, name, parent_id, archived_at
+			FROM teams
+			WHERE parent_id IS NOT DISTINCT FROM $1
+			UNION ALL
+			SELECT t.id, t.name, t.parent_id, t.archived_at
+			FROM teams t
+			JOIN tree ON t.parent_id = tree.id
+		)
+		SELECT id, name, parent_id, archived_at
+		FROM tree
+		ORDER BY name`,
+		rootID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Top-level teams are kept under 0, which no team id takes.
+	children := make(map[int64][]TeamNode)
+	for rows.Next() {
+		var (
+			node     TeamNode
+			parentID *int64
+		)
+		if err := rows.Scan(&node.ID, &node.Name, &parentID, &node.ArchivedAt); err != nil {
+			return nil, err
+		}
+		var key int64
+		if parentID != nil {
+			key = *parentID
+		}
+		children[key] = append(children[key], node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var root int64
+	if rootID != nil {
+		root = *rootID
+	}
+	return buildTeamTree(children, root), nil
+}
+
+func buildTeamTree(children map[int64][]TeamNode, parentID int64) []TeamNode {
+	nodes := children[parentID]
+	for i := range nodes {
+		nodes[i].SubTeams = buildTeamTree(children, nodes[i].ID)
+	}
+	return nodes
+}
+
+// loadActiveAncestorIDs returns the ids of the team's parent, grandparent and
+// so on up to the top of the tree, nearest first, skipping archived ones.
+func loadActiveAncestorIDs(ctx context.Context, q querier, teamID int64) ([]int64, error) {
+	rows, err := q.Query(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT parent_id AS id, 1 AS depth
+			FROM teams
+			WHERE id = $1
+			  AND parent_id IS NOT NULL
+			UNION ALL
+			SELECT t.parent_id, a.depth + 1
+			FROM teams t
+			JOIN ancestors a ON t.id = a.id
+			WHERE t.parent_id IS NOT NULL
+		)
+		SELECT a.id
+		FROM ancestors a
+		JOIN teams t ON t.id = a.id
+		WHERE t.archived_at IS NULL
+		ORDER BY a.depth`,
+		teamID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
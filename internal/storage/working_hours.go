@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const clockLayout = "15:04"
+
+func workingHours(timezone, start, end *string) *WorkingHours {
+	if timezone == nil || start == nil || end == nil {
+		return nil
+	}
+	return &WorkingHours{Timezone: *timezone, Start: *start, End: *end}
+}
+
+func (w WorkingHours) validate() error {
+	if w.Timezone == "" || w.Timezone == "Local" {
+		return ErrInvalidWorkingHours
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil {
+		return ErrInvalidWorkingHours
+	}
+	start, err := time.Parse(clockLayout, w.Start)
+	if err != nil {
+		return ErrInvalidWorkingHours
+	}
+	end, err := time.Parse(clockLayout, w.End)
+	if err != nil || start.Equal(end) {
+		return ErrInvalidWorkingHours
+	}
+	return nil
+}
+
+// Contains reports whether the moment falls inside the working hours. A window
+// whose end is before its start spans midnight. Hours in a time zone unknown to
+// this process are treated as always on duty.
+func (w WorkingHours) Contains(at time.Time) bool {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return true
+	}
+	start, err := time.Parse(clockLayout, w.Start)
+	if err != nil {
+		return true
+	}
+	end, err := time.Parse(clockLayout, w.End)
+	if err != nil {
+		return true
+	}
+
+	local := at.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	if from < to {
+		return minute >= from && minute < to
+	}
+	return minute >= from || minute < to
+}
+
+// UpdateUserWorkingHours sets the user's time zone and working hours. Nil hours
+// remove the schedule, making the user count as on duty at any time.
+func (r *Repository) UpdateUserWorkingHours(ctx context.Context, userID string, hours *WorkingHours) (User, error) {
+	var timezone, start, end *string
+	if hours != nil {
+		if err := hours.validate(); err != nil {
+			return User{}, err
+		}
+		timezone, start, end = &hours.Timezone, &hours.Start, &hours.End
+	}
+
+	u, err := scanUser(r.pool.QueryRow(ctx, `
+		UPDATE users
+		SET timezone = $2,
+		    work_start = $3::time,
+		    work_end = $4::time,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+userReturning,
+		userID, timezone, start, end,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, err
+	}
+	return u, nil
+}
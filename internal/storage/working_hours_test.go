@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestWorkingHoursContains(t *testing.T) {
+	at := func(value string) time.Time {
+		t.Helper()
+		ts, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ts
+	}
+
+	day := WorkingHours{Timezone: "Europe/Moscow", Start: "09:00", End: "18:00"}
+	night := WorkingHours{Timezone: "UTC", Start: "22:00", End: "06:00"}
+	newYork := WorkingHours{Timezone: "America/New_York", Start: "09:00", End: "17:00"}
+
+	tests := []struct {
+		name  string
+		hours WorkingHours
+		at    time.Time
+		want  bool
+	}{
+		{"inside a day window", day, at("2025-06-02T08:00:00Z"), true},
+		{"start is inclusive", day, at("2025-06-02T06:00:00Z"), true},
+		{"end is exclusive", day, at("2025-06-02T15:00:00Z"), false},
+		{"before a day window", day, at("2025-06-02T05:59:00Z"), false},
+		{"overnight window before midnight", night, at("2025-06-02T23:30:00Z"), true},
+		{"overnight window after midnight", night, at("2025-06-03T05:59:00Z"), true},
+		{"overnight window end is exclusive", night, at("2025-06-03T06:00:00Z"), false},
+		{"outside an overnight window", night, at("2025-06-03T12:00:00Z"), false},
+		{"standard time before the DST switch", newYork, at("2025-03-07T13:30:00Z"), false},
+		{"daylight time after the DST switch", newYork, at("2025-03-10T13:30:00Z"), true},
+		{"daylight time before the switch back", newYork, at("2025-10-31T21:30:00Z"), false},
+		{"standard time after the switch back", newYork, at("2025-11-03T21:30:00Z"), true},
+		{"unknown time zone counts as on duty", WorkingHours{Timezone: "Mars/Olympus", Start: "09:00", End: "10:00"}, at("2025-06-02T23:00:00Z"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hours.Contains(tt.at); got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/avito/pr-reviewer-assignment-service/internal/storage"
+)
+
+// RunReactivation periodically turns users back on once their unavailability
+// period has ended. It blocks until ctx is cancelled.
+func RunReactivation(ctx context.Context, repo *storage.Repository, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			userIDs, err := repo.ReactivateAfterUnavailability(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("reactivation sweep failed: %v", err)
+				}
+				continue
+			}
+			if len(userIDs) > 0 {
+				log.Printf("reactivated users after unavailability: %v", userIDs)
+			}
+		}
+	}
+}